@@ -0,0 +1,121 @@
+package interval
+
+// Relation is one of the 13 relations of Allen's interval algebra, which tells how two intervals lie with respect
+// to each other.
+type Relation int
+
+const (
+	// Unrelated is returned when one of the intervals is empty.
+	Unrelated Relation = iota
+	// Before means the interval ends before the other begins, with values between them: [0, 2) and (3, 5].
+	Before
+	// Meets means the interval ends where the other begins, without values in common: [0, 3) and [3, 5].
+	Meets
+	// Overlaps means the interval begins first and ends within the other: [0, 3] and [2, 5].
+	Overlaps
+	// Starts means the intervals begin together and the interval ends first: [0, 3] and [0, 5].
+	Starts
+	// During means the interval begins after and ends before the other: [1, 3] and [0, 5].
+	During
+	// Finishes means the intervals end together and the interval begins last: [2, 5] and [0, 5].
+	Finishes
+	// Equals means the intervals begin and end together: [0, 5] and [0, 5].
+	Equals
+	// FinishedBy is the inverse of Finishes.
+	FinishedBy
+	// Contains is the inverse of During.
+	Contains
+	// StartedBy is the inverse of Starts.
+	StartedBy
+	// OverlappedBy is the inverse of Overlaps.
+	OverlappedBy
+	// MetBy is the inverse of Meets.
+	MetBy
+	// After is the inverse of Before.
+	After
+)
+
+var relationNames = [...]string{"Unrelated", "Before", "Meets", "Overlaps", "Starts", "During", "Finishes", "Equals",
+	"FinishedBy", "Contains", "StartedBy", "OverlappedBy", "MetBy", "After"}
+
+func (r Relation) String() string {
+	if r < 0 || int(r) >= len(relationNames) {
+		return "Relation(?)"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of x to i when i has relation r to x, like After for Before.
+func (r Relation) Inverse() Relation {
+	if r == Unrelated {
+		return Unrelated
+	}
+	return After + Before - r
+}
+
+// Relate returns the Allen relation of receiver interval to x_interval_string interval. Bounds begin and end as
+// they are written: an included bound before an excluded one at the same value at the begin, and after it at the
+// end, and an unbounded side before or after every bound. So [0, 5) Starts [0, 5], and [0, 3) Meets [3, 5] as
+// [0, 3] Meets (3, 5], both touching without a value in common. For integer types intervals without an integer
+// between them meet, so [0, 4] Meets [5, 9]. Unrelated is returned if one of them is empty.
+func (i *Interval[T]) Relate(x IInterval[T]) Relation {
+	if x == nil || x.IsEmpty() || i.IsEmpty() {
+		return Unrelated
+	}
+	lower, upper := compareLower[T](i, x), compareUpper[T](i, x)
+	switch {
+	case lower == 0 && upper == 0:
+		return Equals
+	case lower == 0 && upper < 0:
+		return Starts
+	case lower == 0:
+		return StartedBy
+	case upper == 0 && lower > 0:
+		return Finishes
+	case upper == 0:
+		return FinishedBy
+	case lower > 0 && upper < 0:
+		return During
+	case lower < 0 && upper > 0:
+		return Contains
+	case lower < 0:
+		return [...]Relation{Before, Meets, Overlaps}[partitionBoundary[T](i, x)+1]
+	}
+	return [...]Relation{After, MetBy, OverlappedBy}[partitionBoundary(x, i)+1]
+}
+
+// Before returns true if receiver interval ends before x_interval_string interval begins, with values between them.
+func (i *Interval[T]) Before(x IInterval[T]) bool {
+	return i.Relate(x) == Before
+}
+
+// After returns true if receiver interval begins after x_interval_string interval ends, with values between them.
+func (i *Interval[T]) After(x IInterval[T]) bool {
+	return i.Relate(x) == After
+}
+
+// Meets returns true if receiver interval ends where x_interval_string interval begins, without a value in common.
+func (i *Interval[T]) Meets(x IInterval[T]) bool {
+	return i.Relate(x) == Meets
+}
+
+// MetBy returns true if receiver interval begins where x_interval_string interval ends, without a value in common.
+func (i *Interval[T]) MetBy(x IInterval[T]) bool {
+	return i.Relate(x) == MetBy
+}
+
+// Starts returns true if receiver interval begins with x_interval_string interval and ends first.
+func (i *Interval[T]) Starts(x IInterval[T]) bool {
+	return i.Relate(x) == Starts
+}
+
+// During returns true if receiver interval begins after and ends before x_interval_string interval. Unlike Contains
+// it is false when they share a bound.
+func (i *Interval[T]) During(x IInterval[T]) bool {
+	return i.Relate(x) == During
+}
+
+// Finishes returns true if receiver interval ends with x_interval_string interval and begins last.
+func (i *Interval[T]) Finishes(x IInterval[T]) bool {
+	return i.Relate(x) == Finishes
+}
@@ -0,0 +1,96 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestIntervalRelate(t *testing.T) {
+	testIntervalRelate[int](t)
+	testIntervalRelate[float64](t)
+}
+
+func testIntervalRelate[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalRelate {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			x, er := parseInterval[T](tc.x_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			if r, inverse := i.Relate(x), x.Relate(i); r != tc.relation || inverse != tc.relation.Inverse() {
+				t.Errorf("want %s.Relate(%s) = %v and the inverse %v but is %v and %v, counter: %v",
+					i, x, tc.relation, tc.relation.Inverse(), r, inverse, n)
+			}
+			for relation, predicate := range map[Relation]func(IInterval[T]) bool{
+				Before: i.Before, After: i.After, Meets: i.Meets, MetBy: i.MetBy,
+				Starts: i.Starts, During: i.During, Finishes: i.Finishes,
+			} {
+				if predicate(x) != (relation == tc.relation) {
+					t.Errorf("want %s.%v(%s) = %v but is %v, counter: %v", i, relation, x, relation == tc.relation, predicate(x), n)
+				}
+			}
+		})
+	}
+	for _, tc := range testsGeneralSets {
+		i, _ := parseInterval[T](tc.i_interval_string)
+		x, _ := parseInterval[T](tc.x_interval_string)
+		r := i.Relate(x)
+		if x.Relate(i) != r.Inverse() {
+			t.Errorf("want %s.Relate(%s) = %v to be the inverse of %v, counter: %v", x, i, x.Relate(i), r, tc.counter)
+		}
+		if (r == Meets || r == MetBy) != i.Abuts(x) || (r != Before && r != After && r != Meets && r != MetBy) != i.Overlaps(x) {
+			t.Errorf("want %s.Relate(%s) = %v to agree with Abuts and Overlaps, counter: %v", i, x, r, tc.counter)
+		}
+	}
+}
+
+func TestIntervalRelateIntegers(t *testing.T) {
+	for n, tc := range []struct {
+		i, x     IInterval[int]
+		relation Relation
+	}{
+		{Closed(0, 4), Closed(5, 9), Meets},
+		{ClosedOpen(0, 5), OpenClosed(4, 9), Meets},
+		{Closed(0, 4), Closed(6, 9), Before},
+		{Closed(5, 9), Closed(0, 4), MetBy},
+	} {
+		if r := tc.i.Relate(tc.x); r != tc.relation {
+			t.Errorf("want %s.Relate(%s) = %v but is %v, counter: %v", tc.i, tc.x, tc.relation, r, n)
+		}
+	}
+}
+
+var testsIntervalRelate = []struct {
+	i_interval_string string
+	x_interval_string string
+	relation          Relation
+}{
+	{"  |=|  ", "  |---===|  ", Before},
+	{"  |===|* ", "  |---===|  ", Meets},
+	{"  |===|  ", " *|---===|  ", Meets},
+	{" *|===|* ", " *|---===|  ", Before},
+	{"  |===|  ", "  |---===|  ", Overlaps},
+	{"  |====|  ", "  |---===|  ", Overlaps},
+	{"  |===|  ", "  |=====|  ", Starts},
+	{"  |=====|* ", "  |=====|  ", Starts},
+	{" *|=====|  ", "  |=====|  ", Finishes},
+	{"  |-==|  ", "  |=====|  ", During},
+	{" *|=====|* ", "  |=====|  ", During},
+	{"  |--===|  ", "  |=====|  ", Finishes},
+	{"  |=====|  ", "  |=====|  ", Equals},
+	{" <|=====|  ", " <|=====|  ", Equals},
+	{" <|==|  ", "  |=====|  ", Overlaps},
+	{" <|=====|>", "  |=====|  ", Contains},
+	{" <|=====|  ", "  |=====|> ", Overlaps},
+	{"  |=====|  ", "  |---&|  ", Contains},
+	{"  |---&|  ", "  |=====|  ", During},
+	{"  |&|  ", "  |=====|  ", Starts},
+	{"  |=====|  ", " *|---&|*  ", Unrelated},
+}
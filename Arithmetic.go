@@ -0,0 +1,296 @@
+package interval
+
+import (
+	"errors"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// The arithmetic here takes intervals as the sets of values a quantity may have, as for measurements with an
+// uncertainty, and returns the interval of values the result may have: [1, 2] + [10, 20] is [11, 22]. A bound is
+// included if it is reached, so [0, 1) * [2, 3] is [0, 3). A bound beyond the range of T makes its side unbounded, so
+// the result still holds every possible value. Floating point bounds are not rounded outward.
+
+// ErrDivisionByZero is returned by Div for a divisor which has the value 0.
+var ErrDivisionByZero = errors.New("interval: division by interval containing zero")
+
+// ErrNegativeSqrt is returned by Sqrt for an interval with negative values.
+var ErrNegativeSqrt = errors.New("interval: square root of interval with negative values")
+
+// endpoint is a side of an interval taking part in arithmetic: its value and whether it is included, or with
+// infinite -1 or 1 an unbounded lower or upper side, whose value is ignored.
+type endpoint[T constraints.Integer | constraints.Float] struct {
+	value    T
+	included bool
+	infinite int
+}
+
+// Add returns the interval of a + b for every value a of x and b of y, or nil if x or y is empty.
+func Add[T constraints.Integer | constraints.Float](x, y IInterval[T]) IInterval[T] {
+	if x == nil || y == nil || x.IsEmpty() || y.IsEmpty() {
+		return nil
+	}
+	return fromEndpoints(sumEnd(lowerEnd(x), lowerEnd(y), -1, add[T]), sumEnd(upperEnd(x), upperEnd(y), 1, add[T]))
+}
+
+// Sub returns the interval of a - b for every value a of x and b of y, or nil if x or y is empty.
+func Sub[T constraints.Integer | constraints.Float](x, y IInterval[T]) IInterval[T] {
+	if x == nil || y == nil || x.IsEmpty() || y.IsEmpty() {
+		return nil
+	}
+	return fromEndpoints(sumEnd(lowerEnd(x), upperEnd(y), -1, sub[T]), sumEnd(upperEnd(x), lowerEnd(y), 1, sub[T]))
+}
+
+// Mul returns the interval of a * b for every value a of x and b of y, or nil if x or y is empty. The signs of the
+// bounds decide which products are the bounds of the result, as [-2, 3] * [4, 5] is [-10, 15].
+func Mul[T constraints.Integer | constraints.Float](x, y IInterval[T]) IInterval[T] {
+	if x == nil || y == nil || x.IsEmpty() || y.IsEmpty() {
+		return nil
+	}
+	return fromEndpoints(extreme(productEnd, lowerEnd(x), upperEnd(x), lowerEnd(y), upperEnd(y)))
+}
+
+// Div returns the interval of a / b for every value a of x and b of y, or nil if x or y is empty. It returns
+// ErrDivisionByZero if y has the value 0, as the quotients would then be two unbounded intervals; an excluded bound 0
+// makes the result unbounded, as [1, 2] / (0, 4] is [0.25, +∞). For integer types the quotients are not truncated:
+// the result is the smallest integer interval which holds every real quotient, as [7, 7] / [2, 2] is [3, 4].
+func Div[T constraints.Integer | constraints.Float](x, y IInterval[T]) (IInterval[T], error) {
+	if x == nil || y == nil || x.IsEmpty() || y.IsEmpty() {
+		return nil, nil
+	}
+	if y.Has(0) {
+		return nil, ErrDivisionByZero
+	}
+	lower, upper := extreme(quotientEnd, toFloat(lowerEnd(x)), toFloat(upperEnd(x)), toFloat(lowerEnd(y)), toFloat(upperEnd(y)))
+	return fromEndpoints(fromFloat[T](lower, -1), fromFloat[T](upper, 1)), nil
+}
+
+// lowerEnd returns the lower side of x as endpoint, included for integer types.
+func lowerEnd[T constraints.Integer | constraints.Float](x IInterval[T]) endpoint[T] {
+	if discrete[T]() {
+		x = closed(x)
+	}
+	if x.LowerUnbounded() {
+		return endpoint[T]{infinite: -1}
+	}
+	return endpoint[T]{value: x.Lower(), included: x.LowerIncluded()}
+}
+
+// upperEnd returns the upper side of x as endpoint, included for integer types.
+func upperEnd[T constraints.Integer | constraints.Float](x IInterval[T]) endpoint[T] {
+	if discrete[T]() {
+		x = closed(x)
+	}
+	if x.UpperUnbounded() {
+		return endpoint[T]{infinite: 1}
+	}
+	return endpoint[T]{value: x.Upper(), included: x.UpperIncluded()}
+}
+
+// fromEndpoints returns the interval from lower to upper, or nil if it is empty.
+func fromEndpoints[T constraints.Integer | constraints.Float](lower, upper endpoint[T]) IInterval[T] {
+	return maybeEmpty(NewInterval[T](lower.value, upper.value, lower.included && lower.infinite == 0, lower.infinite != 0, upper.included && upper.infinite == 0, upper.infinite != 0))
+}
+
+// sign returns -1, 0 or 1 for a negative, zero or positive endpoint.
+func (e endpoint[T]) sign() int {
+	switch {
+	case e.infinite != 0:
+		return e.infinite
+	case e.value < 0:
+		return -1
+	case e.value > 0:
+		return 1
+	}
+	return 0
+}
+
+// isZero returns true if e is the bound 0.
+func (e endpoint[T]) isZero() bool {
+	return e.infinite == 0 && e.value == 0
+}
+
+// sumEnd returns the side of a sum or difference made by op from p and q; side is -1 for a lower and 1 for an
+// upper side, which becomes unbounded if p or q is unbounded or op overflows.
+func sumEnd[T constraints.Integer | constraints.Float](p, q endpoint[T], side int, op func(v, d T) (T, int)) endpoint[T] {
+	if p.infinite != 0 || q.infinite != 0 {
+		return endpoint[T]{infinite: side}
+	}
+	r, overflow := op(p.value, q.value)
+	if overflow != 0 || infinite(r) != 0 {
+		return endpoint[T]{infinite: side}
+	}
+	return endpoint[T]{value: r, included: p.included && q.included}
+}
+
+// productEnd returns the product of p and q. An included 0 makes an included 0, also with an unbounded side.
+func productEnd[T constraints.Integer | constraints.Float](p, q endpoint[T]) endpoint[T] {
+	if p.isZero() || q.isZero() {
+		return endpoint[T]{included: p.isZero() && p.included || q.isZero() && q.included}
+	}
+	if p.infinite != 0 || q.infinite != 0 {
+		return endpoint[T]{infinite: p.sign() * q.sign()}
+	}
+	r, overflow := mul(p.value, q.value)
+	if overflow != 0 || infinite(r) != 0 {
+		return endpoint[T]{infinite: p.sign() * q.sign()}
+	}
+	return endpoint[T]{value: r, included: p.included && q.included}
+}
+
+// quotientEnd returns the quotient of p and q, where q is not an included 0. An excluded 0 is approached from the
+// side of its interval, which is told by whether it is a lower or upper bound: the sign of q.value, set by extreme.
+func quotientEnd(p, q endpoint[float64]) endpoint[float64] {
+	switch {
+	case p.isZero():
+		return endpoint[float64]{included: p.included}
+	case q.infinite != 0:
+		return endpoint[float64]{}
+	case q.value == 0:
+		return endpoint[float64]{infinite: p.sign() * int(math.Copysign(1, q.value))}
+	case p.infinite != 0:
+		return endpoint[float64]{infinite: p.sign() * q.sign()}
+	}
+	r := p.value / q.value
+	if math.IsInf(r, 0) {
+		return endpoint[float64]{infinite: p.sign() * q.sign()}
+	}
+	return endpoint[float64]{value: r, included: p.included && q.included}
+}
+
+// extreme returns the lowest and highest of the results of op on the bounds of [xLower, xUpper] and
+// [yLower, yUpper], as the bounds of a product or quotient. A bound 0 of the second interval is given to op with
+// the sign of the side it is approached from, -0 for an upper bound.
+func extreme[T constraints.Integer | constraints.Float](op func(p, q endpoint[T]) endpoint[T], xLower, xUpper, yLower, yUpper endpoint[T]) (lower, upper endpoint[T]) {
+	if yUpper.isZero() {
+		yUpper.value = T(math.Copysign(0, -1))
+	}
+	results := [4]endpoint[T]{op(xLower, yLower), op(xLower, yUpper), op(xUpper, yLower), op(xUpper, yUpper)}
+	lower, upper = results[0], results[0]
+	for _, r := range results[1:] {
+		if compareEnd(r, lower) < 0 || compareEnd(r, lower) == 0 && r.included {
+			lower = r
+		}
+		if compareEnd(r, upper) > 0 || compareEnd(r, upper) == 0 && r.included {
+			upper = r
+		}
+	}
+	if lower.infinite > 0 || upper.infinite < 0 {
+		lower, upper = endpoint[T]{infinite: -1}, endpoint[T]{infinite: 1}
+	}
+	return lower, upper
+}
+
+// compareEnd orders endpoints by value, regardless of whether they are included.
+func compareEnd[T constraints.Integer | constraints.Float](p, q endpoint[T]) int {
+	switch {
+	case p.infinite != q.infinite:
+		if p.infinite < q.infinite {
+			return -1
+		}
+		return 1
+	case p.infinite != 0 || p.value == q.value:
+		return 0
+	case p.value < q.value:
+		return -1
+	}
+	return 1
+}
+
+// toFloat returns e with its value as float64.
+func toFloat[T constraints.Integer | constraints.Float](e endpoint[T]) endpoint[float64] {
+	return endpoint[float64]{value: float64(e.value), included: e.included, infinite: e.infinite}
+}
+
+// fromFloat returns e with its value as T; side is -1 for a lower and 1 for an upper side. For integer types the
+// value is rounded outward to an included integer, and the side becomes unbounded if it is beyond the range of T.
+func fromFloat[T constraints.Integer | constraints.Float](e endpoint[float64], side int) endpoint[T] {
+	if e.infinite != 0 {
+		return endpoint[T]{infinite: e.infinite}
+	}
+	if !discrete[T]() {
+		return endpoint[T]{value: T(e.value), included: e.included}
+	}
+	v := math.Floor(e.value)
+	if side > 0 {
+		v = math.Ceil(e.value)
+	}
+	lowest, highest := extremes[T]()
+	if v < float64(lowest) || v >= float64(highest)+1 {
+		return endpoint[T]{infinite: side}
+	}
+	return endpoint[T]{value: T(v), included: e.included || v != e.value}
+}
+
+// Map returns the interval of f(v) for every value v of receiver interval, where f is increasing, or decreasing if
+// increasing is false, so as to convert Celsius into Fahrenheit. f is applied to the bounds, which keep whether they
+// are included and change sides for a decreasing f; an unbounded side stays unbounded. Map returns nil if receiver
+// interval is empty.
+func (i *Interval[T]) Map(f func(T) T, increasing bool) IInterval[T] {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return nil
+	}
+	lower, upper := i.lower, i.upper
+	if lower.Kind != Unbounded {
+		lower.Value = f(lower.Value)
+	}
+	if upper.Kind != Unbounded {
+		upper.Value = f(upper.Value)
+	}
+	if !increasing {
+		lower, upper = upper, lower
+	}
+	return maybeEmpty(NewInterval[T](lower.Value, upper.Value, lower.Kind == ClosedBound, lower.Kind == Unbounded, upper.Kind == ClosedBound, upper.Kind == Unbounded))
+}
+
+// Neg returns the interval of -a for every value a of x, which has its bounds reversed, or nil if x is empty.
+func Neg[T constraints.Integer | constraints.Float](x IInterval[T]) IInterval[T] {
+	return Sub(Point[T](0), x)
+}
+
+// Abs returns the interval of |a| for every value a of x, or nil if x is empty. An interval on both sides of 0 is
+// folded onto it, as [-3, 2) becomes [0, 3].
+func Abs[T constraints.Integer | constraints.Float](x IInterval[T]) IInterval[T] {
+	if x == nil || x.IsEmpty() {
+		return nil
+	}
+	lower, upper := lowerEnd(x), upperEnd(x)
+	switch {
+	case lower.sign() >= 0:
+		return fromEndpoints(lower, upper)
+	case upper.sign() <= 0:
+		return Neg(x)
+	}
+	zero := endpoint[T]{included: true}
+	folded := sumEnd(zero, lower, 1, sub[T])
+	if compareEnd(upper, folded) > 0 || compareEnd(upper, folded) == 0 && upper.included {
+		folded = upper
+	}
+	return fromEndpoints(zero, folded)
+}
+
+// Sqr returns the interval of a * a for every value a of x, or nil if x is empty. Unlike Mul(x, x) it has no
+// negative values, as the same value is multiplied by itself: [-1, 2] squared is [0, 4].
+func Sqr[T constraints.Integer | constraints.Float](x IInterval[T]) IInterval[T] {
+	a := Abs(x)
+	return Mul(a, a)
+}
+
+// Sqrt returns the interval of the square roots of the values of x, or nil if x is empty. It returns ErrNegativeSqrt
+// if x has negative values. For integer types the result is the smallest integer interval which holds every real
+// square root, as for Div.
+func Sqrt[T constraints.Integer | constraints.Float](x IInterval[T]) (IInterval[T], error) {
+	if x == nil || x.IsEmpty() {
+		return nil, nil
+	}
+	lower, upper := toFloat(lowerEnd(x)), toFloat(upperEnd(x))
+	if lower.sign() < 0 {
+		return nil, ErrNegativeSqrt
+	}
+	lower.value = math.Sqrt(lower.value)
+	if upper.infinite == 0 {
+		upper.value = math.Sqrt(upper.value)
+	}
+	return fromEndpoints(fromFloat[T](lower, -1), fromFloat[T](upper, 1)), nil
+}
@@ -0,0 +1,150 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAddSub(t *testing.T) {
+	for _, tc := range []struct {
+		x, y      IInterval[float64]
+		sum, diff IInterval[float64]
+	}{
+		{Closed(1.0, 2), Closed(10.0, 20), Closed(11.0, 22), Closed(-19.0, -8)},
+		{ClosedOpen(1.0, 2), Closed(10.0, 20), ClosedOpen(11.0, 22), ClosedOpen(-19.0, -8)},
+		{AtLeast(1.0), Closed(10.0, 20), AtLeast(11.0), AtLeast(-19.0)},
+		{Closed(1.0, 2), Less(5.0), Less(7.0), Greater(-4.0)},
+		{Closed(1.0, 2), Open(3.0, 3), nil, nil},
+	} {
+		if got := Add(tc.x, tc.y); !equalOrEmpty(got, tc.sum) {
+			t.Errorf("want %s + %s = %v but get %v", tc.x, tc.y, tc.sum, got)
+		}
+		if got := Sub(tc.x, tc.y); !equalOrEmpty(got, tc.diff) {
+			t.Errorf("want %s - %s = %v but get %v", tc.x, tc.y, tc.diff, got)
+		}
+	}
+	if got := Add[int8](Closed[int8](100, 120), Closed[int8](10, 20)); !got.Equal(AtLeast[int8](110)) {
+		t.Errorf("want an overflowing upper side to be unbounded but get %s", got)
+	}
+	if got := Add(Open(0, 5), Open(0, 5)); !got.Equal(Closed(2, 8)) {
+		t.Errorf("want (0,5) + (0,5) of int = [2,8] but get %s", got)
+	}
+	if got := Sub[uint](Closed[uint](5, 9), Closed[uint](1, 7)); !got.Equal(AtMost[uint](8)) {
+		t.Errorf("want a lower side below 0 of uint to be unbounded but get %s", got)
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	for _, tc := range []struct {
+		x, y IInterval[float64]
+		want IInterval[float64]
+	}{
+		{Closed(2.0, 3), Closed(4.0, 5), Closed(8.0, 15)},
+		{Closed(-2.0, 3), Closed(4.0, 5), Closed(-10.0, 15)},
+		{Closed(-2.0, 3), Closed(-5.0, -4), Closed(-15.0, 10)},
+		{Closed(-2.0, 3), Closed(-1.0, 4), Closed(-8.0, 12)},
+		{ClosedOpen(0.0, 1), Closed(2.0, 3), ClosedOpen(0.0, 3)},
+		{OpenClosed(0.0, 1), Closed(2.0, 3), OpenClosed(0.0, 3)},
+		{Point(0.0), All[float64](), Point(0.0)},
+		{AtLeast(1.0), Closed(-2.0, -1), AtMost(-1.0)},
+		{AtLeast(1.0), Closed(-2.0, 1), All[float64]()},
+	} {
+		if got := Mul(tc.x, tc.y); !equalOrEmpty(got, tc.want) {
+			t.Errorf("want %s * %s = %v but get %v", tc.x, tc.y, tc.want, got)
+		}
+		if got := Mul(tc.y, tc.x); !equalOrEmpty(got, tc.want) {
+			t.Errorf("want %s * %s = %v but get %v", tc.y, tc.x, tc.want, got)
+		}
+	}
+	if got := Mul[int8](Closed[int8](-100, 2), Closed[int8](2, 3)); !got.Equal(AtMost[int8](6)) {
+		t.Errorf("want an overflowing lower side to be unbounded but get %s", got)
+	}
+	for _, tc := range []struct {
+		x, y IInterval[float64]
+		want IInterval[float64]
+	}{
+		{Closed(1.0, 2), Closed(4.0, 8), Closed(0.125, 0.5)},
+		{Closed(-1.0, 2), Closed(-4.0, -2), Closed(-1.0, 0.5)},
+		{Closed(1.0, 2), OpenClosed(0.0, 4), AtLeast(0.25)},
+		{Closed(1.0, 2), ClosedOpen(-4.0, 0), AtMost(-0.25)},
+		{Closed(0.0, 2), OpenClosed(0.0, 4), AtLeast(0.0)},
+		{Closed(1.0, 2), AtLeast(1.0), OpenClosed(0.0, 2)},
+		{AtLeast(1.0), Closed(2.0, 4), AtLeast(0.25)},
+	} {
+		if got, er := Div(tc.x, tc.y); er != nil || !equalOrEmpty(got, tc.want) {
+			t.Errorf("want %s / %s = %v but get %v, %v", tc.x, tc.y, tc.want, got, er)
+		}
+	}
+	if _, er := Div(Closed(1.0, 2), Closed(-1.0, 1)); !errors.Is(er, ErrDivisionByZero) {
+		t.Errorf("want ErrDivisionByZero but get %v", er)
+	}
+	if got, er := Div(Closed(7, 7), Closed(2, 2)); er != nil || !got.Equal(Closed(3, 4)) {
+		t.Errorf("want [7,7] / [2,2] of int = [3,4] but get %v, %v", got, er)
+	}
+	if got, er := Div(Closed(6, 8), Open(1, 3)); er != nil || !got.Equal(Closed(3, 4)) {
+		t.Errorf("want [6,8] / (1,3) of int = [3,4] but get %v, %v", got, er)
+	}
+	if got, er := Div(Closed[int64](math.MinInt64, 0), Closed[int64](1, 2)); er != nil || !got.Equal(Closed[int64](math.MinInt64, 0)) {
+		t.Errorf("want [MinInt64,0] / [1,2] = [MinInt64,0] but get %v, %v", got, er)
+	}
+}
+
+func TestIntervalMapMonotonic(t *testing.T) {
+	fahrenheit := func(c float64) float64 { return c*9/5 + 32 }
+	if got := ClosedOpen(0.0, 100).Map(fahrenheit, true); !got.Equal(ClosedOpen(32.0, 212)) {
+		t.Errorf("want [0,100) in Fahrenheit = [32,212) but get %s", got)
+	}
+	if got := AtLeast(-40.0).Map(fahrenheit, true); !got.Equal(AtLeast(-40.0)) {
+		t.Errorf("want [-40,+∞) in Fahrenheit = [-40,+∞) but get %s", got)
+	}
+	negate := func(v int) int { return -v }
+	if got := ClosedOpen(1, 5).Map(negate, false); !got.Equal(OpenClosed(-5, -1)) {
+		t.Errorf("want [1,5) negated = (-5,-1] but get %s", got)
+	}
+	if got := Less(3).Map(negate, false); !got.Equal(Greater(-3)) {
+		t.Errorf("want (-∞,3) negated = (-3,+∞) but get %s", got)
+	}
+	if got := Open(2, 2).Map(negate, false); got != nil {
+		t.Errorf("want nil for an empty interval but get %s", got)
+	}
+}
+
+func TestUnary(t *testing.T) {
+	for _, tc := range []struct {
+		x             IInterval[float64]
+		neg, abs, sqr IInterval[float64]
+	}{
+		{ClosedOpen(1.0, 3), OpenClosed(-3.0, -1), ClosedOpen(1.0, 3), ClosedOpen(1.0, 9)},
+		{ClosedOpen(-3.0, 2), OpenClosed(-2.0, 3), Closed(0.0, 3), Closed(0.0, 9)},
+		{Open(-2.0, 3), Open(-3.0, 2), ClosedOpen(0.0, 3), ClosedOpen(0.0, 9)},
+		{Open(-3.0, -1), Open(1.0, 3), Open(1.0, 3), Open(1.0, 9)},
+		{AtLeast(-1.0), AtMost(1.0), AtLeast(0.0), AtLeast(0.0)},
+		{Open(1.0, 1), nil, nil, nil},
+	} {
+		if got := Neg(tc.x); !equalOrEmpty(got, tc.neg) {
+			t.Errorf("want Neg(%s) = %v but get %v", tc.x, tc.neg, got)
+		}
+		if got := Abs(tc.x); !equalOrEmpty(got, tc.abs) {
+			t.Errorf("want Abs(%s) = %v but get %v", tc.x, tc.abs, got)
+		}
+		if got := Sqr(tc.x); !equalOrEmpty(got, tc.sqr) {
+			t.Errorf("want Sqr(%s) = %v but get %v", tc.x, tc.sqr, got)
+		}
+	}
+	if got := Neg[int8](Closed[int8](-128, 0)); !got.Equal(AtLeast[int8](0)) {
+		t.Errorf("want -(-128) of int8 to make the upper side unbounded but get %s", got)
+	}
+	if got, er := Sqrt(ClosedOpen(4.0, 9)); er != nil || !got.Equal(ClosedOpen(2.0, 3)) {
+		t.Errorf("want Sqrt([4,9)) = [2,3) but get %v, %v", got, er)
+	}
+	if got, er := Sqrt(AtLeast(0.0)); er != nil || !got.Equal(AtLeast(0.0)) {
+		t.Errorf("want Sqrt([0,+∞)) = [0,+∞) but get %v, %v", got, er)
+	}
+	if got, er := Sqrt(Closed(2, 10)); er != nil || !got.Equal(Closed(1, 4)) {
+		t.Errorf("want Sqrt([2,10]) of int = [1,4] but get %v, %v", got, er)
+	}
+	if _, er := Sqrt(Closed(-1.0, 4)); !errors.Is(er, ErrNegativeSqrt) {
+		t.Errorf("want ErrNegativeSqrt but get %v", er)
+	}
+}
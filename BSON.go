@@ -0,0 +1,166 @@
+package interval
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// ErrBSON is returned by UnmarshalBSONValue for a value which is not an interval written by MarshalBSONValue.
+var ErrBSON = errors.New("interval: invalid BSON")
+
+// BSON types used by MarshalBSONValue and UnmarshalBSONValue.
+const (
+	bsonDouble   = 0x01
+	bsonDocument = 0x03
+	bsonBoolean  = 0x08
+	bsonNull     = 0x0a
+	bsonInt32    = 0x10
+	bsonInt64    = 0x12
+)
+
+// MarshalBSONValue implements bson.ValueMarshaler of the MongoDB Go driver v2, without depending on it. The interval
+// is written as the document {v: 2, lower: 0, upper: 5, lowerIncluded: true, upperIncluded: false}, like its JSON,
+// with bounds as double for floating point types and int64 otherwise, null for an unbounded side and null for the
+// empty interval. It has a value receiver, so that intervals in structs are written also when they are not
+// addressable.
+func (i Interval[T]) MarshalBSONValue() (byte, []byte, error) {
+	if i.IsEmpty() {
+		return bsonNull, nil, nil
+	}
+	doc := make([]byte, 4, 80)
+	doc = appendBSONElement(doc, bsonInt32, "v", binary.LittleEndian.AppendUint32(nil, SchemaVersion))
+	for _, side := range []struct {
+		name string
+		b    Bound[T]
+	}{{"lower", i.lower}, {"upper", i.upper}} {
+		switch {
+		case side.b.Kind == Unbounded:
+			doc = appendBSONElement(doc, bsonNull, side.name, nil)
+		case discrete[T]():
+			doc = appendBSONElement(doc, bsonInt64, side.name, binary.LittleEndian.AppendUint64(nil, uint64(side.b.Value)))
+		default:
+			doc = appendBSONElement(doc, bsonDouble, side.name, binary.LittleEndian.AppendUint64(nil, math.Float64bits(float64(side.b.Value))))
+		}
+	}
+	doc = appendBSONElement(doc, bsonBoolean, "lowerIncluded", []byte{bsonBool(i.lower.Kind == ClosedBound)})
+	doc = appendBSONElement(doc, bsonBoolean, "upperIncluded", []byte{bsonBool(i.upper.Kind == ClosedBound)})
+	doc = append(doc, 0)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+	return bsonDocument, doc, nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler of the MongoDB Go driver v2, reading a document written by
+// MarshalBSONValue, or null as the empty interval. Bounds may be double, int32 or int64. Bounds which do not make a
+// valid interval return the errors of NewIntervalChecked.
+func (i *Interval[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == bsonNull {
+		*i = Interval[T]{}
+		return nil
+	}
+	if typ != bsonDocument || len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data) || data[len(data)-1] != 0 {
+		return fmt.Errorf("%w: not a document", ErrBSON)
+	}
+	var lower, upper T
+	lowerUnbounded, upperUnbounded := true, true
+	var lowerIncluded, upperIncluded bool
+	version := 0
+	rest := data[4 : len(data)-1]
+	for len(rest) > 0 {
+		typ, name, value, next, er := nextBSONElement(rest)
+		if er != nil {
+			return er
+		}
+		rest = next
+		switch name {
+		case "v":
+			v, ok := bsonNumber[int](typ, value)
+			if !ok {
+				return fmt.Errorf("%w: v is not a number", ErrBSON)
+			}
+			version = v
+		case "lower", "upper":
+			v, ok := bsonNumber[T](typ, value)
+			if !ok && typ != bsonNull {
+				return fmt.Errorf("%w: %s is not a number or null", ErrBSON, name)
+			}
+			if name == "lower" {
+				lower, lowerUnbounded = v, !ok
+			} else {
+				upper, upperUnbounded = v, !ok
+			}
+		case "lowerIncluded", "upperIncluded":
+			if typ != bsonBoolean {
+				return fmt.Errorf("%w: %s is not a boolean", ErrBSON, name)
+			}
+			if name == "lowerIncluded" {
+				lowerIncluded = value[0] != 0
+			} else {
+				upperIncluded = value[0] != 0
+			}
+		}
+	}
+	if er := CheckSchemaVersion(version); er != nil {
+		return er
+	}
+	x, er := NewIntervalChecked(lower, upper, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded)
+	if er != nil {
+		return er
+	}
+	*i = *x
+	return nil
+}
+
+// bsonSizes are the lengths of the values of the BSON types read by UnmarshalBSONValue.
+var bsonSizes = map[byte]int{bsonDouble: 8, bsonBoolean: 1, bsonNull: 0, bsonInt32: 4, bsonInt64: 8}
+
+func appendBSONElement(doc []byte, typ byte, name string, value []byte) []byte {
+	doc = append(doc, typ)
+	doc = append(doc, name...)
+	doc = append(doc, 0)
+	return append(doc, value...)
+}
+
+// nextBSONElement returns the first element of the elements of a document, and the elements after it. Only the types
+// written by MarshalBSONValue are read.
+func nextBSONElement(elements []byte) (typ byte, name string, value, rest []byte, err error) {
+	typ = elements[0]
+	end := 1
+	for end < len(elements) && elements[end] != 0 {
+		end++
+	}
+	if end == len(elements) {
+		return 0, "", nil, nil, fmt.Errorf("%w: unterminated name", ErrBSON)
+	}
+	name = string(elements[1:end])
+	n, ok := bsonSizes[typ]
+	if !ok {
+		return 0, "", nil, nil, fmt.Errorf("%w: %s has unsupported type %#x", ErrBSON, name, typ)
+	}
+	if len(elements) < end+1+n {
+		return 0, "", nil, nil, fmt.Errorf("%w: %s is truncated", ErrBSON, name)
+	}
+	return typ, name, elements[end+1 : end+1+n], elements[end+1+n:], nil
+}
+
+// bsonNumber returns the value of a double, int32 or int64 as T.
+func bsonNumber[T constraints.Integer | constraints.Float](typ byte, value []byte) (T, bool) {
+	switch typ {
+	case bsonDouble:
+		return T(math.Float64frombits(binary.LittleEndian.Uint64(value))), true
+	case bsonInt32:
+		return T(int32(binary.LittleEndian.Uint32(value))), true
+	case bsonInt64:
+		return T(int64(binary.LittleEndian.Uint64(value))), true
+	}
+	return 0, false
+}
+
+func bsonBool(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
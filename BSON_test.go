@@ -0,0 +1,67 @@
+package interval
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntervalBSON(t *testing.T) {
+	testIntervalBSON(t, []*Interval[int64]{ClosedOpen[int64](0, 5), AtMost[int64](math.MinInt64 + 1), All[int64](), Empty[int64]()})
+	testIntervalBSON(t, []*Interval[float64]{OpenClosed(-2.5, 7.25), AtLeast(1e300)})
+}
+
+func testIntervalBSON[T int64 | float64](t *testing.T, intervals []*Interval[T]) {
+	for _, x := range intervals {
+		typ, data, er := x.MarshalBSONValue()
+		if er != nil {
+			t.Errorf("want MarshalBSONValue of %s to succeed but get %v", x, er)
+		}
+		var y Interval[T]
+		if er := y.UnmarshalBSONValue(typ, data); er != nil || !y.Equal(x) {
+			t.Errorf("want UnmarshalBSONValue to read back %s but get %s, %v", x, &y, er)
+		}
+	}
+}
+
+func TestIntervalBSONDocument(t *testing.T) {
+	typ, data, _ := ClosedOpen(0, 5).MarshalBSONValue()
+	want := "\x4a\x00\x00\x00" +
+		"\x10v\x00\x02\x00\x00\x00" +
+		"\x12lower\x00\x00\x00\x00\x00\x00\x00\x00\x00" +
+		"\x12upper\x00\x05\x00\x00\x00\x00\x00\x00\x00" +
+		"\x08lowerIncluded\x00\x01" +
+		"\x08upperIncluded\x00\x00" +
+		"\x00"
+	if typ != bsonDocument || string(data) != want {
+		t.Errorf("want the document %q but get %#x %q", want, typ, data)
+	}
+
+	// A document as another writer may store it: an int32 bound, an unbounded side left out and no version.
+	doc := "\x00\x00\x00\x00" +
+		"\x10lower\x00\x01\x00\x00\x00" +
+		"\x08lowerIncluded\x00\x01" +
+		"\x00"
+	b := []byte(doc)
+	binary.LittleEndian.PutUint32(b, uint32(len(b)))
+	var x Interval[int]
+	if er := x.UnmarshalBSONValue(bsonDocument, b); er != nil || !x.Equal(AtLeast(1)) {
+		t.Errorf("want the document to be read as [1,+∞) but get %s, %v", &x, er)
+	}
+	for _, tc := range []struct {
+		typ  byte
+		data []byte
+		err  error
+	}{
+		{0x02, data, ErrBSON},
+		{bsonDocument, data[:len(data)-1], ErrBSON},
+		{bsonDocument, []byte("\x0e\x00\x00\x00\x02lower\x00\x00\x00"), ErrBSON},
+		{bsonDocument, []byte("\x0c\x00\x00\x00\x10v\x00\x03\x00\x00\x00\x00"), ErrSchemaVersion},
+		{bsonDocument, []byte("\x1b\x00\x00\x00\x10lower\x00\x05\x00\x00\x00\x10upper\x00\x01\x00\x00\x00\x00"), ErrReversedBounds},
+	} {
+		if er := x.UnmarshalBSONValue(tc.typ, tc.data); !errors.Is(er, tc.err) {
+			t.Errorf("want UnmarshalBSONValue(%#x, %q) to fail with %v but get %v", tc.typ, tc.data, tc.err, er)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package interval
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// ErrBinary is returned by UnmarshalBinary for data which is not an interval written by MarshalBinary.
+var ErrBinary = errors.New("interval: invalid binary form")
+
+// binarySize is the length of the binary form: the schema version, the kinds of the lower and upper side in the low
+// and high nibble of one byte, and the lower and upper bound as 8 bytes big-endian each.
+const binarySize = 18
+
+func init() {
+	gob.Register(new(Interval[int]))
+	gob.Register(new(Interval[int8]))
+	gob.Register(new(Interval[int16]))
+	gob.Register(new(Interval[int32]))
+	gob.Register(new(Interval[int64]))
+	gob.Register(new(Interval[uint]))
+	gob.Register(new(Interval[uint8]))
+	gob.Register(new(Interval[uint16]))
+	gob.Register(new(Interval[uint32]))
+	gob.Register(new(Interval[uint64]))
+	gob.Register(new(Interval[uintptr]))
+	gob.Register(new(Interval[float32]))
+	gob.Register(new(Interval[float64]))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with a fixed layout of 18 bytes, which is also used by gob. It
+// has a value receiver, so that intervals in structs are written also when they are not addressable.
+func (i Interval[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, binarySize)
+	data[0] = SchemaVersion
+	data[1] = byte(i.lower.Kind) | byte(i.upper.Kind)<<4
+	binary.BigEndian.PutUint64(data[2:], bits(i.lower.Value))
+	binary.BigEndian.PutUint64(data[10:], bits(i.upper.Value))
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for data written by MarshalBinary.
+func (i *Interval[T]) UnmarshalBinary(data []byte) error {
+	if len(data) != binarySize {
+		return fmt.Errorf("%w: %d bytes, want %d", ErrBinary, len(data), binarySize)
+	}
+	if er := CheckSchemaVersion(int(data[0])); er != nil {
+		return er
+	}
+	lowerKind, upperKind := BoundKind(data[1]&0xf), BoundKind(data[1]>>4)
+	if lowerKind > Unbounded || upperKind > Unbounded {
+		return fmt.Errorf("%w: bound kinds %#x", ErrBinary, data[1])
+	}
+	i.lower = Bound[T]{fromBits[T](binary.BigEndian.Uint64(data[2:])), lowerKind}
+	i.upper = Bound[T]{fromBits[T](binary.BigEndian.Uint64(data[10:])), upperKind}
+	return nil
+}
+
+// bits returns v as 64 bits: the IEEE 754 bits of a floating point value, or an integer converted to uint64.
+func bits[T constraints.Integer | constraints.Float](v T) uint64 {
+	if discrete[T]() {
+		return uint64(v)
+	}
+	return math.Float64bits(float64(v))
+}
+
+// fromBits returns the value of T written by bits.
+func fromBits[T constraints.Integer | constraints.Float](b uint64) T {
+	if discrete[T]() {
+		return T(b)
+	}
+	return T(math.Float64frombits(b))
+}
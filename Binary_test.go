@@ -0,0 +1,61 @@
+package interval
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntervalBinary(t *testing.T) {
+	testIntervalBinary(t, []*Interval[int64]{ClosedOpen[int64](math.MinInt64, math.MaxInt64), AtMost[int64](-3), Empty[int64]()})
+	testIntervalBinary(t, []*Interval[uint8]{Closed[uint8](0, 255), Greater[uint8](7)})
+	testIntervalBinary(t, []*Interval[float64]{OpenClosed(-2.5, 1e300), All[float64](), NewInterval(5.0, 1, true, false, true, false)})
+}
+
+func testIntervalBinary[T int64 | uint8 | float64](t *testing.T, intervals []*Interval[T]) {
+	for _, x := range intervals {
+		data, er := x.MarshalBinary()
+		if er != nil || len(data) != binarySize {
+			t.Errorf("want MarshalBinary of %s to be %d bytes but get %d, %v", x, binarySize, len(data), er)
+		}
+		var y Interval[T]
+		if er := y.UnmarshalBinary(data); er != nil || y != *x {
+			t.Errorf("want UnmarshalBinary to read back %s but get %s, %v", x, &y, er)
+		}
+	}
+}
+
+func TestIntervalBinaryErrors(t *testing.T) {
+	data, _ := ClosedOpen(0, 5).MarshalBinary()
+	var x Interval[int]
+	for _, tc := range []struct {
+		data []byte
+		err  error
+	}{
+		{data[:17], ErrBinary},
+		{append([]byte{3}, data[1:]...), ErrSchemaVersion},
+		{append([]byte{2, 0x30}, data[2:]...), ErrBinary},
+	} {
+		if er := x.UnmarshalBinary(tc.data); !errors.Is(er, tc.err) {
+			t.Errorf("want UnmarshalBinary(%v) to fail with %v but get %v", tc.data, tc.err, er)
+		}
+	}
+}
+
+func TestIntervalGob(t *testing.T) {
+	type cached struct {
+		Window Interval[float64]
+		Any    IInterval[int]
+	}
+	in := cached{Window: *ClosedOpen(0.5, 5), Any: AtLeast(3)}
+	var b bytes.Buffer
+	if er := gob.NewEncoder(&b).Encode(in); er != nil {
+		t.Fatalf("want gob to encode %+v but get %v", in, er)
+	}
+	var out cached
+	if er := gob.NewDecoder(&b).Decode(&out); er != nil || out.Window != in.Window || !out.Any.Equal(in.Any) {
+		t.Errorf("want gob to decode %s and %s but get %s and %v, %v", &in.Window, in.Any, &out.Window, out.Any, er)
+	}
+}
@@ -0,0 +1,71 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrBitmapRange is returned for a value which a bitmap or T cannot hold, as a negative value for a bitmap.
+var ErrBitmapRange = errors.New("interval: value out of range of bitmap")
+
+// BitmapAdder is the part of a bitmap ToBitmap writes to. The bitmaps of github.com/RoaringBitmap/roaring and its
+// roaring64 package have this method, so they can be used without this package depending on them.
+type BitmapAdder interface {
+	// AddRange adds the values from rangeStart to rangeEnd, which is excluded.
+	AddRange(rangeStart, rangeEnd uint64)
+}
+
+// BitmapIterator is the part of a bitmap FromBitmap reads from, as *roaring.Bitmap with uint32 values and
+// *roaring64.Bitmap with uint64 values.
+type BitmapIterator[V uint32 | uint64] interface {
+	// Iterate passes the values of the bitmap to cb, ascending, until cb returns false.
+	Iterate(cb func(x V) bool)
+}
+
+// ToBitmap adds the integers of s to b, one range per interval. An unbounded upper side runs to the highest value of
+// T. It returns ErrBitmapRange, after adding the intervals before it, for an interval with negative values or which
+// runs to the highest uint64, as a range cannot end beyond it. A 32-bit bitmap must only be given values it holds.
+func ToBitmap[T constraints.Integer](s *IntervalSet[T], b BitmapAdder) error {
+	for x := range s.All() {
+		lower, upper := integerBounds(x)
+		end := uint64(upper) + 1
+		if lower < 0 || end == 0 {
+			return fmt.Errorf("%w: %s", ErrBitmapRange, x)
+		}
+		b.AddRange(uint64(lower), end)
+	}
+	return nil
+}
+
+// FromBitmap returns the set of the values of b, merging consecutive values into one interval as they are read. It
+// returns ErrBitmapRange for a value T cannot hold.
+func FromBitmap[T constraints.Integer, V uint32 | uint64](b BitmapIterator[V]) (*IntervalSet[T], error) {
+	s := new(IntervalSet[T])
+	var er error
+	var lower, upper T
+	started := false
+	b.Iterate(func(x V) bool {
+		v := T(x)
+		if v < 0 || V(v) != x {
+			er = fmt.Errorf("%w: %d", ErrBitmapRange, x)
+			return false
+		}
+		if started && v == upper+1 {
+			upper = v
+			return true
+		}
+		if started {
+			s.push(Closed(lower, upper))
+		}
+		lower, upper, started = v, v, true
+		return true
+	})
+	if er != nil {
+		return nil, er
+	}
+	if started {
+		s.push(Closed(lower, upper))
+	}
+	return s, nil
+}
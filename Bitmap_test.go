@@ -0,0 +1,55 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// bitmap is a plain bitmap with the methods of roaring bitmaps used by ToBitmap and FromBitmap.
+type bitmap[V uint32 | uint64] struct {
+	values []V
+}
+
+func (b *bitmap[V]) AddRange(rangeStart, rangeEnd uint64) {
+	for v := rangeStart; v < rangeEnd; v++ {
+		b.values = append(b.values, V(v))
+	}
+}
+
+func (b *bitmap[V]) Iterate(cb func(x V) bool) {
+	for _, v := range b.values {
+		if !cb(v) {
+			return
+		}
+	}
+}
+
+func TestBitmap(t *testing.T) {
+	s := NewIntervalSet[int](Closed(1, 3), Open(5, 9), Point(12))
+	b := new(bitmap[uint32])
+	if er := ToBitmap(s, b); er != nil || !slices.Equal(b.values, []uint32{1, 2, 3, 6, 7, 8, 12}) {
+		t.Errorf("want the values of %s but get %v, %v", s, b.values, er)
+	}
+	got, er := FromBitmap[int](b)
+	if er != nil || !got.Equal(s) {
+		t.Errorf("want %s from the bitmap but get %v, %v", s, got, er)
+	}
+	if got, er := FromBitmap[uint8](&bitmap[uint64]{[]uint64{0, 1, 2, 255}}); er != nil || got.String() != "{[0,2], [255,255]}" {
+		t.Errorf("want {[0,2], [255,255]} but get %v, %v", got, er)
+	}
+	if _, er := FromBitmap[int8](&bitmap[uint32]{[]uint32{1, 200}}); !errors.Is(er, ErrBitmapRange) {
+		t.Errorf("want %v for a value beyond int8 but get %v", ErrBitmapRange, er)
+	}
+	if er := ToBitmap(NewIntervalSet[int](Closed(-1, 1)), new(bitmap[uint32])); !errors.Is(er, ErrBitmapRange) {
+		t.Errorf("want %v for a negative value but get %v", ErrBitmapRange, er)
+	}
+	if er := ToBitmap(NewIntervalSet[uint64](Point[uint64](math.MaxUint64)), new(bitmap[uint64])); !errors.Is(er, ErrBitmapRange) {
+		t.Errorf("want %v for the highest uint64 but get %v", ErrBitmapRange, er)
+	}
+	empty, er := FromBitmap[int](new(bitmap[uint64]))
+	if er != nil || !empty.IsEmpty() {
+		t.Errorf("want an empty set but get %v, %v", empty, er)
+	}
+}
@@ -0,0 +1,86 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// BoundKind tells whether a side of an interval excludes its bound, includes it or has none.
+type BoundKind int
+
+const (
+	// OpenBound means the bound is not in the interval, as 5 in [0, 5).
+	OpenBound BoundKind = iota
+	// ClosedBound means the bound is in the interval, as 0 in [0, 5).
+	ClosedBound
+	// Unbounded means the side has no bound, and its value is ignored.
+	Unbounded
+)
+
+func (k BoundKind) String() string {
+	switch k {
+	case OpenBound:
+		return "open"
+	case ClosedBound:
+		return "closed"
+	case Unbounded:
+		return "unbounded"
+	}
+	return fmt.Sprintf("BoundKind(%d)", int(k))
+}
+
+// Bound is a side of an interval: its value and whether the value is included, or that there is no bound. The zero
+// Bound is the open bound 0.
+type Bound[T constraints.Integer | constraints.Float] struct {
+	Value T
+	Kind  BoundKind
+}
+
+// newBound returns the bound of a side with the flags of NewInterval, where unbounded wins over included.
+func newBound[T constraints.Integer | constraints.Float](value T, included, unbounded bool) Bound[T] {
+	switch {
+	case unbounded:
+		return Bound[T]{value, Unbounded}
+	case included:
+		return Bound[T]{value, ClosedBound}
+	}
+	return Bound[T]{value, OpenBound}
+}
+
+// setIncluded makes a bounded side closed or open. An unbounded side stays unbounded.
+func (b *Bound[T]) setIncluded(included bool) {
+	if b.Kind != Unbounded {
+		*b = newBound(b.Value, included, false)
+	}
+}
+
+// setUnbounded makes a side unbounded, or an unbounded side open.
+func (b *Bound[T]) setUnbounded(unbounded bool) {
+	if unbounded {
+		b.Kind = Unbounded
+	} else if b.Kind == Unbounded {
+		b.Kind = OpenBound
+	}
+}
+
+// LowerBound returns the lower side of receiver interval.
+func (i *Interval[T]) LowerBound() Bound[T] {
+	return i.orEmpty().lower
+}
+
+// UpperBound returns the upper side of receiver interval.
+func (i *Interval[T]) UpperBound() Bound[T] {
+	return i.orEmpty().upper
+}
+
+// LowerValue returns the lower bound of receiver interval, and false if the lower side is unbounded.
+func (i *Interval[T]) LowerValue() (T, bool) {
+	b := i.LowerBound()
+	return b.Value, b.Kind != Unbounded
+}
+
+// UpperValue returns the upper bound of receiver interval, and false if the upper side is unbounded.
+func (i *Interval[T]) UpperValue() (T, bool) {
+	b := i.UpperBound()
+	return b.Value, b.Kind != Unbounded
+}
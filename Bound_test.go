@@ -0,0 +1,60 @@
+package interval
+
+import (
+	"testing"
+)
+
+func TestIntervalBound(t *testing.T) {
+	for _, tc := range []struct {
+		i            *Interval[int]
+		lower, upper Bound[int]
+	}{
+		{Closed(1, 5), Bound[int]{1, ClosedBound}, Bound[int]{5, ClosedBound}},
+		{OpenClosed(1, 5), Bound[int]{1, OpenBound}, Bound[int]{5, ClosedBound}},
+		{AtLeast(1), Bound[int]{1, ClosedBound}, Bound[int]{0, Unbounded}},
+		{NewInterval(1, 5, true, true, true, false), Bound[int]{1, Unbounded}, Bound[int]{5, ClosedBound}},
+		{nil, Bound[int]{}, Bound[int]{}},
+	} {
+		if l, u := tc.i.LowerBound(), tc.i.UpperBound(); l != tc.lower || u != tc.upper {
+			t.Errorf("want bounds of %s = %v, %v but get %v, %v", tc.i, tc.lower, tc.upper, l, u)
+		}
+	}
+	i := NewInterval(1, 5, true, true, true, false)
+	if i.LowerIncluded() {
+		t.Errorf("want the unbounded side of %s not to be included", i)
+	}
+	i.SetLowerIncluded(true)
+	if !i.LowerUnbounded() || i.LowerIncluded() {
+		t.Errorf("want SetLowerIncluded to leave an unbounded side unbounded but get %s", i)
+	}
+	i.SetLowerUnbounded(false)
+	if b := i.LowerBound(); b != (Bound[int]{1, OpenBound}) {
+		t.Errorf("want SetLowerUnbounded(false) to make the side open but get %v", b)
+	}
+	i.SetLowerIncluded(true)
+	if b := i.LowerBound(); b != (Bound[int]{1, ClosedBound}) {
+		t.Errorf("want SetLowerIncluded(true) to make the side closed but get %v", b)
+	}
+	if s := Unbounded.String(); s != "unbounded" {
+		t.Errorf("want Unbounded.String() = unbounded but get %s", s)
+	}
+}
+
+func TestIntervalValue(t *testing.T) {
+	for _, tc := range []struct {
+		i                *Interval[float64]
+		lower, upper     float64
+		lowerOk, upperOk bool
+	}{
+		{ClosedOpen(1.5, 5), 1.5, 5, true, true},
+		{AtLeast(1.5), 1.5, 0, true, false},
+		{Less(5.0), 0, 5, false, true},
+		{All[float64](), 0, 0, false, false},
+	} {
+		lower, lowerOk := tc.i.LowerValue()
+		upper, upperOk := tc.i.UpperValue()
+		if lowerOk != tc.lowerOk || upperOk != tc.upperOk || lowerOk && lower != tc.lower || upperOk && upper != tc.upper {
+			t.Errorf("want values of %s = %v, %v and %v, %v but get %v, %v and %v, %v", tc.i, tc.lower, tc.lowerOk, tc.upper, tc.upperOk, lower, lowerOk, upper, upperOk)
+		}
+	}
+}
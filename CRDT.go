@@ -0,0 +1,104 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// GrowOnlySet is an interval set which replicas can only add to, a conflict-free replicated data type: merging
+// replicas in any order, any number of times, gives the same set.
+type GrowOnlySet[T constraints.Integer | constraints.Float] struct {
+	added IntervalSet[T]
+	// delta holds what was added since the last call of Delta.
+	delta IntervalSet[T]
+}
+
+func NewGrowOnlySet[T constraints.Integer | constraints.Float]() *GrowOnlySet[T] {
+	return new(GrowOnlySet[T])
+}
+
+// Add puts the values of x in the set.
+func (g *GrowOnlySet[T]) Add(x IInterval[T]) {
+	for _, novel := range g.added.uncovered(x) {
+		g.added.add(novel)
+		g.delta.add(novel)
+	}
+}
+
+// Merge adds every value of other to the set. Merge is commutative, associative and idempotent.
+func (g *GrowOnlySet[T]) Merge(other *GrowOnlySet[T]) {
+	for _, x := range other.added.intervals {
+		g.Add(x)
+	}
+}
+
+// Delta returns a set of only the values added since the previous call of Delta, including those added by Merge.
+// Sending deltas to other replicas, which merge them, is enough to converge and far smaller than the whole set.
+func (g *GrowOnlySet[T]) Delta() *GrowOnlySet[T] {
+	d := new(GrowOnlySet[T])
+	d.added = g.delta
+	g.delta = IntervalSet[T]{}
+	return d
+}
+
+// Has returns true if value is in the set.
+func (g *GrowOnlySet[T]) Has(value T) bool {
+	return g.added.Has(value)
+}
+
+// Intervals returns the intervals of the set, ascending.
+func (g *GrowOnlySet[T]) Intervals() []IInterval[T] {
+	return g.added.Intervals()
+}
+
+func (g *GrowOnlySet[T]) String() string {
+	return g.added.String()
+}
+
+// TwoPhaseSet is an interval set which replicas can add to and remove from, a conflict-free replicated data type.
+// A removed value stays removed: adding it again has no effect.
+type TwoPhaseSet[T constraints.Integer | constraints.Float] struct {
+	added   GrowOnlySet[T]
+	removed GrowOnlySet[T]
+}
+
+func NewTwoPhaseSet[T constraints.Integer | constraints.Float]() *TwoPhaseSet[T] {
+	return new(TwoPhaseSet[T])
+}
+
+// Add puts the values of x which were never removed in the set.
+func (p *TwoPhaseSet[T]) Add(x IInterval[T]) {
+	p.added.Add(x)
+}
+
+// Remove takes the values of x out of the set, for good.
+func (p *TwoPhaseSet[T]) Remove(x IInterval[T]) {
+	p.removed.Add(x)
+}
+
+// Merge adds the additions and removals of other to the set. Merge is commutative, associative and idempotent.
+func (p *TwoPhaseSet[T]) Merge(other *TwoPhaseSet[T]) {
+	p.added.Merge(&other.added)
+	p.removed.Merge(&other.removed)
+}
+
+// Delta returns a set of only the additions and removals since the previous call of Delta.
+func (p *TwoPhaseSet[T]) Delta() *TwoPhaseSet[T] {
+	d := new(TwoPhaseSet[T])
+	d.added = *p.added.Delta()
+	d.removed = *p.removed.Delta()
+	return d
+}
+
+// Has returns true if value was added and not removed.
+func (p *TwoPhaseSet[T]) Has(value T) bool {
+	return p.added.Has(value) && !p.removed.Has(value)
+}
+
+// Intervals returns the intervals of the values which were added and not removed, ascending.
+func (p *TwoPhaseSet[T]) Intervals() []IInterval[T] {
+	var intervals []IInterval[T]
+	for _, x := range p.added.added.intervals {
+		intervals = append(intervals, p.removed.added.uncovered(x)...)
+	}
+	return intervals
+}
@@ -0,0 +1,76 @@
+package interval
+
+import (
+	"testing"
+)
+
+func TestGrowOnlySet(t *testing.T) {
+	a, b, c := NewGrowOnlySet[int](), NewGrowOnlySet[int](), NewGrowOnlySet[int]()
+	a.Add(NewInterval(0, 10, true, false, false, false))
+	b.Add(NewInterval(5, 20, true, false, false, false))
+	c.Add(NewInterval(30, 40, true, false, true, false))
+	c.Add(NewInterval(20, 25, false, false, false, false))
+
+	// (a + b) + c
+	x := NewGrowOnlySet[int]()
+	x.Merge(a)
+	x.Merge(b)
+	x.Merge(c)
+	// c + (b + a), twice
+	y := NewGrowOnlySet[int]()
+	y.Merge(c)
+	ba := NewGrowOnlySet[int]()
+	ba.Merge(b)
+	ba.Merge(a)
+	y.Merge(ba)
+	y.Merge(ba)
+	y.Merge(c)
+	if x.String() != y.String() {
+		t.Errorf("want merges in any order to converge but get %s and %s", x, y)
+	}
+	if x.String() != "{[0,20), (20,25), [30,40]}" {
+		t.Errorf("want merged set {[0,20), (20,25), [30,40]} but get %s", x)
+	}
+
+	// Replica z only receives deltas of a.
+	z := NewGrowOnlySet[int]()
+	z.Merge(a.Delta())
+	a.Merge(b)
+	d := a.Delta()
+	if d.String() != "{[10,20)}" {
+		t.Errorf("want delta {[10,20)} but get %s", d)
+	}
+	z.Merge(d)
+	if z.String() != a.String() {
+		t.Errorf("want merging deltas to converge to %s but get %s", a, z)
+	}
+	if !z.Has(19) || z.Has(20) {
+		t.Errorf("want %s to have 19 and not 20", z)
+	}
+}
+
+func TestTwoPhaseSet(t *testing.T) {
+	a, b := NewTwoPhaseSet[float64](), NewTwoPhaseSet[float64]()
+	a.Add(NewInterval(0.0, 10, true, false, true, false))
+	b.Remove(NewInterval(2.0, 4, true, false, false, false))
+	b.Add(NewInterval(3.0, 12, true, false, true, false))
+	a.Merge(b.Delta())
+	b.Merge(a.Delta())
+	for _, p := range []*TwoPhaseSet[float64]{a, b} {
+		intervals := p.Intervals()
+		want := []IInterval[float64]{
+			NewInterval(0.0, 2, true, false, false, false),
+			NewInterval(4.0, 12, true, false, true, false),
+		}
+		if len(intervals) != 2 || !intervals[0].Equal(want[0]) || !intervals[1].Equal(want[1]) {
+			t.Errorf("want replicas to converge to %v but get %v", want, intervals)
+		}
+		if p.Has(3) || !p.Has(4) {
+			t.Errorf("want 3 to be removed and 4 to remain")
+		}
+	}
+	a.Add(NewInterval(2.0, 4, true, false, false, false))
+	if a.Has(3) {
+		t.Errorf("want a removed value to stay removed")
+	}
+}
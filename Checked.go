@@ -0,0 +1,35 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrReversedBounds is returned for a lower bound above the upper bound.
+var ErrReversedBounds = errors.New("interval: lower bound above upper bound")
+
+// ErrUnboundedIncluded is returned for an unbounded side whose bound is also included, which has no meaning.
+var ErrUnboundedIncluded = errors.New("interval: unbounded side has an included bound")
+
+// NewIntervalChecked is NewInterval, which returns an error for bounds which do not make a valid interval: ErrNaN for
+// a NaN bound, ErrUnboundedIncluded for an included bound on an unbounded side and ErrReversedBounds for a lower
+// bound above the upper bound. Equal bounds which are not both included make a valid, empty interval.
+func NewIntervalChecked[T constraints.Integer | constraints.Float](lower, upper T, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool) (*Interval[T], error) {
+	if isNaN(lower) && !lowerUnbounded {
+		return nil, fmt.Errorf("%w: lower", ErrNaN)
+	}
+	if isNaN(upper) && !upperUnbounded {
+		return nil, fmt.Errorf("%w: upper", ErrNaN)
+	}
+	if lowerUnbounded && lowerIncluded {
+		return nil, fmt.Errorf("%w: lower", ErrUnboundedIncluded)
+	}
+	if upperUnbounded && upperIncluded {
+		return nil, fmt.Errorf("%w: upper", ErrUnboundedIncluded)
+	}
+	if !lowerUnbounded && !upperUnbounded && lower > upper {
+		return nil, fmt.Errorf("%w: %v > %v", ErrReversedBounds, lower, upper)
+	}
+	return NewInterval(lower, upper, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded), nil
+}
@@ -0,0 +1,46 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewIntervalChecked(t *testing.T) {
+	nan := math.NaN()
+	for _, tc := range []struct {
+		lower, upper                   float64
+		lowerUnbounded, upperUnbounded bool
+		err                            error
+	}{
+		{0, 5, false, false, nil},
+		{nan, 5, false, false, ErrNaN},
+		{0, nan, false, false, ErrNaN},
+		{math.Inf(-1), math.Inf(1), false, false, nil},
+	} {
+		i, er := NewIntervalChecked(tc.lower, tc.upper, !tc.lowerUnbounded, tc.lowerUnbounded, false, tc.upperUnbounded)
+		if !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) || (i == nil) != (er != nil) {
+			t.Errorf("want NewIntervalChecked(%v, %v) to fail with %v but get %v, %v", tc.lower, tc.upper, tc.err, i, er)
+		}
+	}
+	if _, er := NewIntervalChecked(0, 5, true, false, false, false); er != nil {
+		t.Errorf("want NewIntervalChecked of integers to succeed but get %v", er)
+	}
+	for _, tc := range []struct {
+		lower, upper                                                 int
+		lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool
+		err                                                          error
+	}{
+		{5, 0, true, false, false, false, ErrReversedBounds},
+		{5, 0, true, false, false, true, nil},
+		{5, 0, false, true, false, false, nil},
+		{5, 5, true, false, false, false, nil},
+		{0, 5, true, true, false, false, ErrUnboundedIncluded},
+		{0, 5, true, false, true, true, ErrUnboundedIncluded},
+	} {
+		i, er := NewIntervalChecked(tc.lower, tc.upper, tc.lowerIncluded, tc.lowerUnbounded, tc.upperIncluded, tc.upperUnbounded)
+		if !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) || (i == nil) != (er != nil) {
+			t.Errorf("want NewIntervalChecked(%+v) to fail with %v but get %v, %v", tc, tc.err, i, er)
+		}
+	}
+}
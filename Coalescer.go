@@ -0,0 +1,65 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrUnsorted is returned by Coalescer.Add for an interval which begins before an interval it already emitted.
+var ErrUnsorted = errors.New("interval: interval out of order")
+
+// Coalescer merges a stream of intervals, which come ascending by their begin, into the intervals of their union,
+// and passes each merged interval on as soon as no later interval can change it, so streams too large to hold in
+// memory can be processed. The order needs to be approximate only: an interval beginning earlier than the one
+// before it is accepted as long as it overlaps or adjoins what has not been emitted yet.
+type Coalescer[T constraints.Integer | constraints.Float] struct {
+	emit func(x IInterval[T])
+	// pending is the merged interval which is not emitted yet, or nil.
+	pending IInterval[T]
+	// emitted is the last interval passed to emit, or nil.
+	emitted IInterval[T]
+}
+
+// NewCoalescer returns a coalescer which passes the merged intervals to emit, ascending.
+func NewCoalescer[T constraints.Integer | constraints.Float](emit func(x IInterval[T])) *Coalescer[T] {
+	c := new(Coalescer[T])
+	c.emit = emit
+	return c
+}
+
+// Add merges x into the stream. It emits the pending merged interval if x begins after it without adjoining it, and
+// returns ErrUnsorted, leaving the stream unchanged, if x begins before the pending interval without overlapping or
+// adjoining it. Empty intervals are skipped.
+func (c *Coalescer[T]) Add(x IInterval[T]) error {
+	switch {
+	case x == nil || x.IsEmpty():
+		return nil
+	case c.pending == nil:
+		if c.emitted != nil && (compareLower(x, c.emitted) < 0 || mergeable(c.emitted, x)) {
+			return fmt.Errorf("%w: %s begins before the end of emitted %s", ErrUnsorted, x, c.emitted)
+		}
+		c.pending = copyOf(x)
+	case mergeable(c.pending, x):
+		if c.emitted != nil && compareLower(x, c.pending) < 0 && mergeable(c.emitted, x) {
+			return fmt.Errorf("%w: %s begins before the end of emitted %s", ErrUnsorted, x, c.emitted)
+		}
+		c.pending = span(c.pending, x)
+	case compareLower(x, c.pending) < 0:
+		return fmt.Errorf("%w: %s begins before pending %s", ErrUnsorted, x, c.pending)
+	default:
+		c.Flush()
+		c.pending = copyOf(x)
+	}
+	return nil
+}
+
+// Flush emits the pending merged interval, at the end of the stream. Intervals added after it must not overlap or
+// adjoin the emitted intervals.
+func (c *Coalescer[T]) Flush() {
+	if c.pending == nil {
+		return
+	}
+	c.emitted, c.pending = c.pending, nil
+	c.emit(c.emitted)
+}
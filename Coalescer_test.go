@@ -0,0 +1,41 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCoalescer(t *testing.T) {
+	var merged []IInterval[int]
+	c := NewCoalescer(func(x IInterval[int]) {
+		merged = append(merged, x)
+	})
+	for _, x := range []IInterval[int]{Closed(0, 5), Closed(3, 8), ClosedOpen(1, 9), nil, ClosedOpen(9, 10), Closed(12, 13), Closed(20, 25), Closed(18, 21), Closed(30, 31)} {
+		if er := c.Add(x); er != nil {
+			t.Fatalf("want %v added but get %v", x, er)
+		}
+	}
+	if len(merged) != 3 || !merged[0].Equal(ClosedOpen(0, 10)) || !merged[1].Equal(Closed(12, 13)) || !merged[2].Equal(Closed(18, 25)) {
+		t.Errorf("want [0,10), [12,13] and [18,25] emitted but get %v", merged)
+	}
+	if er := c.Add(Closed(15, 16)); !errors.Is(er, ErrUnsorted) {
+		t.Errorf("want %v for an interval before the pending one but get %v", ErrUnsorted, er)
+	}
+	c.Flush()
+	if len(merged) != 4 || !merged[3].Equal(Closed(30, 31)) {
+		t.Errorf("want [30,31] emitted by Flush but get %v", merged)
+	}
+	if er := c.Add(Closed(28, 30)); !errors.Is(er, ErrUnsorted) {
+		t.Errorf("want %v for an interval overlapping an emitted one but get %v", ErrUnsorted, er)
+	}
+	if er := c.Add(Greater(31)); !errors.Is(er, ErrUnsorted) {
+		t.Errorf("want %v for an interval adjoining an emitted one but get %v", ErrUnsorted, er)
+	}
+	if er := c.Add(Greater(40)); er != nil {
+		t.Errorf("want (40,+∞) added but get %v", er)
+	}
+	c.Flush()
+	if len(merged) != 5 || !merged[4].Equal(Greater(40)) {
+		t.Errorf("want (40,+∞) emitted but get %v", merged)
+	}
+}
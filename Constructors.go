@@ -0,0 +1,77 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Closed returns the interval [a, b].
+func Closed[T constraints.Integer | constraints.Float](a, b T) *Interval[T] {
+	return NewInterval(a, b, true, false, true, false)
+}
+
+// Open returns the interval (a, b).
+func Open[T constraints.Integer | constraints.Float](a, b T) *Interval[T] {
+	return NewInterval(a, b, false, false, false, false)
+}
+
+// OpenClosed returns the interval (a, b].
+func OpenClosed[T constraints.Integer | constraints.Float](a, b T) *Interval[T] {
+	return NewInterval(a, b, false, false, true, false)
+}
+
+// ClosedOpen returns the interval [a, b).
+func ClosedOpen[T constraints.Integer | constraints.Float](a, b T) *Interval[T] {
+	return NewInterval(a, b, true, false, false, false)
+}
+
+// AtLeast returns the interval [a, +∞).
+func AtLeast[T constraints.Integer | constraints.Float](a T) *Interval[T] {
+	return NewInterval(a, 0, true, false, false, true)
+}
+
+// Greater returns the interval (a, +∞).
+func Greater[T constraints.Integer | constraints.Float](a T) *Interval[T] {
+	return NewInterval(a, 0, false, false, false, true)
+}
+
+// AtMost returns the interval (-∞, b].
+func AtMost[T constraints.Integer | constraints.Float](b T) *Interval[T] {
+	return NewInterval(0, b, false, true, true, false)
+}
+
+// Less returns the interval (-∞, b).
+func Less[T constraints.Integer | constraints.Float](b T) *Interval[T] {
+	return NewInterval(0, b, false, true, false, false)
+}
+
+// All returns the interval (-∞, +∞), which has every value.
+func All[T constraints.Integer | constraints.Float]() *Interval[T] {
+	return NewInterval[T](0, 0, false, true, false, true)
+}
+
+// Empty returns an interval without values, the zero Interval (0, 0).
+func Empty[T constraints.Integer | constraints.Float]() *Interval[T] {
+	return new(Interval[T])
+}
+
+// FromValues returns the smallest closed interval which has all vs, from the lowest to the highest. NaN values are
+// skipped, as they are in no interval; without other values the result is Empty.
+func FromValues[T constraints.Integer | constraints.Float](vs ...T) *Interval[T] {
+	var r *Interval[T]
+	for _, v := range vs {
+		switch {
+		case isNaN(v):
+		case r == nil:
+			r = Point(v)
+		case v < r.lower.Value:
+			r.lower.Value = v
+		case v > r.upper.Value:
+			r.upper.Value = v
+		}
+	}
+	if r == nil {
+		return Empty[T]()
+	}
+	r.markInfinite()
+	return r
+}
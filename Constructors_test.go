@@ -0,0 +1,50 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConstructors(t *testing.T) {
+	for _, tc := range []struct {
+		got, want *Interval[int]
+	}{
+		{Closed(1, 5), NewInterval(1, 5, true, false, true, false)},
+		{Open(1, 5), NewInterval(1, 5, false, false, false, false)},
+		{OpenClosed(1, 5), NewInterval(1, 5, false, false, true, false)},
+		{ClosedOpen(1, 5), NewInterval(1, 5, true, false, false, false)},
+		{AtLeast(1), NewInterval(1, 0, true, false, false, true)},
+		{Greater(1), NewInterval(1, 0, false, false, false, true)},
+		{AtMost(5), NewInterval(0, 5, false, true, true, false)},
+		{Less(5), NewInterval(0, 5, false, true, false, false)},
+		{All[int](), NewInterval(3, 4, false, true, false, true)},
+	} {
+		if !tc.got.Equal(tc.want) {
+			t.Errorf("want %s but get %s", tc.want, tc.got)
+		}
+	}
+	if e := Empty[float64](); !e.IsEmpty() || e.Has(0) {
+		t.Errorf("want Empty() to be empty but get %s", e)
+	}
+	if a := All[float64](); !a.Has(-1e300) || !a.Has(1e300) {
+		t.Errorf("want All() to have every value but get %s", a)
+	}
+}
+
+func TestFromValues(t *testing.T) {
+	if got := FromValues(4, -2, 7, 0); !got.Equal(Closed(-2, 7)) {
+		t.Errorf("want [-2,7] but get %s", got)
+	}
+	if got := FromValues(3.5); !got.Equal(Point(3.5)) {
+		t.Errorf("want [3.5,3.5] but get %s", got)
+	}
+	if got := FromValues(math.NaN(), 1, math.NaN(), -1); !got.Equal(Closed(-1.0, 1)) {
+		t.Errorf("want NaN to be skipped but get %s", got)
+	}
+	if got := FromValues(2, math.Inf(1)); !got.Equal(AtLeast(2.0)) {
+		t.Errorf("want [2,+∞) but get %s", got)
+	}
+	if got := FromValues[int](); !got.IsEmpty() {
+		t.Errorf("want an empty interval without values but get %s", got)
+	}
+}
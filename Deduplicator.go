@@ -0,0 +1,31 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Deduplicator remembers which ranges have been announced, so that of overlapping or out of order announcements
+// only the parts which were not covered before are processed, as in backfill and replication pipelines.
+type Deduplicator[T constraints.Integer | constraints.Float] struct {
+	covered IntervalSet[T]
+}
+
+func NewDeduplicator[T constraints.Integer | constraints.Float]() *Deduplicator[T] {
+	return new(Deduplicator[T])
+}
+
+// Novel returns the parts of x which were not covered by an earlier announcement, ascending, and records x as
+// covered.
+func (d *Deduplicator[T]) Novel(x IInterval[T]) []IInterval[T] {
+	if x == nil || x.IsEmpty() {
+		return nil
+	}
+	novel := d.covered.uncovered(x)
+	d.covered.add(x)
+	return novel
+}
+
+// Covered returns the ranges covered by the announcements so far, ascending.
+func (d *Deduplicator[T]) Covered() []IInterval[T] {
+	return d.covered.Intervals()
+}
@@ -0,0 +1,95 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestDeduplicator(t *testing.T) {
+	testDeduplicator[int](t)
+	testDeduplicator[float64](t)
+}
+
+func testDeduplicator[T constraints.Integer | constraints.Float](t *testing.T) {
+	d := NewDeduplicator[T]()
+	for n, tc := range testsDeduplicator {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			x, er := parseInterval[T](tc.announced)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			novel := d.Novel(x)
+			if !equalIntervals(t, novel, tc.novel) {
+				t.Errorf("want Novel(%s) = %v but is %v, counter: %v", x, tc.novel, novel, tc.counter)
+			}
+			if covered := d.Covered(); !equalIntervals(t, covered, tc.covered) {
+				t.Errorf("want Covered() = %v but is %v after %s, counter: %v", tc.covered, covered, x, tc.counter)
+			}
+		})
+	}
+}
+
+// equalIntervals returns true if the intervals are equal to the intervals written in the test-set strings.
+func equalIntervals[T constraints.Integer | constraints.Float](t *testing.T, intervals []IInterval[T], s []string) bool {
+	if len(intervals) != len(s) {
+		return false
+	}
+	for n := range s {
+		w, er := parseInterval[T](s[n])
+		if er != nil {
+			t.Errorf(er.Error())
+			return false
+		}
+		if !intervals[n].Equal(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// The test-sets are announced one after another to the same Deduplicator.
+var testsDeduplicator = []struct {
+	announced string
+	novel     []string
+	covered   []string
+	counter   string
+}{
+	{
+		announced: "  |----====|*     ",
+		novel:     []string{"  |----====|*     "},
+		covered:   []string{"  |----====|*     "},
+		counter:   "0",
+	},
+	{
+		announced: "  |------====|*   ",
+		novel:     []string{"  |--------==|*   "},
+		covered:   []string{"  |----======|*   "},
+		counter:   "1",
+	},
+	{
+		announced: "  |-------------===|*",
+		novel:     []string{"  |-------------===|*"},
+		covered:   []string{"  |----======|*   ", "  |-------------===|*"},
+		counter:   "2",
+	},
+	{
+		announced: "  |==================|*",
+		novel:     []string{"  |====|*", "  |----------===|*", "  |----------------==|*"},
+		covered:   []string{"  |==================|*"},
+		counter:   "3",
+	},
+	{
+		announced: "  |---======|*",
+		novel:     nil,
+		covered:   []string{"  |==================|*"},
+		counter:   "4",
+	},
+	{
+		announced: "  |------------------=====|>",
+		novel:     []string{"  |------------------=====|>"},
+		covered:   []string{"  |==================|>"},
+		counter:   "5",
+	},
+}
@@ -0,0 +1,81 @@
+package interval
+
+import (
+	"math"
+)
+
+// DistanceTo returns how far value is from receiver interval: 0 if it is in the interval, and otherwise the gap to
+// the nearest bound, to snap a value to the nearest allowed one. For integer types an excluded bound does not count,
+// so the distance from 0 to (0, 5) is 1, the distance to 1, its lowest value; for floating point types the distance
+// to an excluded bound is 0, as there is no nearest value beyond it. An empty interval, which has no values to be
+// near, is at the highest value of T, +Inf for floating point types; a NaN value is at NaN.
+func (i *Interval[T]) DistanceTo(value T) T {
+	i = i.orEmpty()
+	if isNaN(value) {
+		return value
+	}
+	if i.IsEmpty() {
+		if !discrete[T]() {
+			return T(math.Inf(1))
+		}
+		_, highest := extremes[T]()
+		return highest
+	}
+	c := i
+	if discrete[T]() {
+		c = closed[T](i)
+	}
+	var d T
+	var overflow int
+	switch {
+	case c.lower.Kind != Unbounded && value < c.lower.Value:
+		d, overflow = sub(c.lower.Value, value)
+	case c.upper.Kind != Unbounded && value > c.upper.Value:
+		d, overflow = sub(value, c.upper.Value)
+	}
+	if overflow != 0 {
+		_, d = extremes[T]()
+	}
+	return d
+}
+
+// OverlapLength returns the length of the intersection of receiver interval and x, as Length does, or 0 if they are
+// disjoint, without making the intersection. An unbounded intersection, or one longer than the highest value of an
+// integer type, has the highest value of T as length, +Inf for floating point types.
+func (i *Interval[T]) OverlapLength(x IInterval[T]) T {
+	i = i.orEmpty()
+	if x == nil || i.IsEmpty() || x.IsEmpty() {
+		return 0
+	}
+	overlap := *i
+	if compareLower(x, i) > 0 {
+		overlap.lower = x.LowerBound()
+	}
+	if compareUpper(x, i) < 0 {
+		overlap.upper = x.UpperBound()
+	}
+	length, ok := overlap.Length()
+	if !ok && discrete[T]() {
+		_, length = extremes[T]()
+	}
+	return length
+}
+
+// Jaccard returns the length of the intersection of receiver interval and x over the length of their union, from 0
+// for disjoint intervals to 1 for equal ones, as to match a detected range against the one expected. Equal intervals,
+// also two empty ones, two equal points or two equal unbounded intervals, give 1. Other intervals whose union has no
+// length or an infinite one give 0, as does an empty interval with one which is not.
+func (i *Interval[T]) Jaccard(x IInterval[T]) float64 {
+	if i.EqualNormalized(x) {
+		return 1
+	}
+	if x == nil || i.IsEmpty() || x.IsEmpty() {
+		return 0
+	}
+	overlap := float64(i.OverlapLength(x))
+	union := measure[T](i) + measure(x) - overlap
+	if union == 0 || math.IsInf(union, 0) || math.IsNaN(union) {
+		return 0
+	}
+	return overlap / union
+}
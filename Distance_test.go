@@ -0,0 +1,99 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntervalDistanceTo(t *testing.T) {
+	for _, tc := range []struct {
+		i     IInterval[int]
+		value int
+		want  int
+	}{
+		{Closed(0, 5), 3, 0},
+		{Closed(0, 5), -2, 2},
+		{Closed(0, 5), 9, 4},
+		{Open(0, 5), 0, 1},
+		{Open(0, 5), 7, 3},
+		{AtMost(5), math.MinInt, 0},
+		{Greater(5), 5, 1},
+		{Open(0, 1), 0, math.MaxInt},
+	} {
+		if got := tc.i.DistanceTo(tc.value); got != tc.want {
+			t.Errorf("want %s.DistanceTo(%d) = %d but get %d", tc.i, tc.value, tc.want, got)
+		}
+	}
+	if got := Open(0.0, 5).DistanceTo(0); got != 0 {
+		t.Errorf("want 0 to an excluded float bound but get %v", got)
+	}
+	if got := Closed(0.5, 1).DistanceTo(-1); got != 1.5 {
+		t.Errorf("want 1.5 but get %v", got)
+	}
+	if got := Closed(0.0, 1).DistanceTo(math.NaN()); !math.IsNaN(got) {
+		t.Errorf("want NaN for NaN but get %v", got)
+	}
+	if got := Closed[int8](100, 127).DistanceTo(-128); got != math.MaxInt8 {
+		t.Errorf("want the highest int8 for a distance which overflows but get %d", got)
+	}
+}
+
+func TestIntervalOverlapLength(t *testing.T) {
+	for _, tc := range []struct {
+		a, b IInterval[int]
+		want int
+	}{
+		{Closed(0, 10), Closed(5, 20), 5},
+		{Closed(0, 10), Closed(2, 3), 1},
+		{Closed(0, 10), Closed(10, 20), 0},
+		{Closed(0, 10), Closed(11, 20), 0},
+		{ClosedOpen(0, 10), OpenClosed(9, 20), 0},
+		{AtLeast(0), AtMost(7), 7},
+		{AtLeast(0), Greater(-5), math.MaxInt},
+		{Closed(0, 10), nil, 0},
+		{Closed(0, 10), Open(3, 3), 0},
+	} {
+		if got := tc.a.OverlapLength(tc.b); got != tc.want {
+			t.Errorf("want %s.OverlapLength(%v) = %d but get %d", tc.a, tc.b, tc.want, got)
+		}
+		if tc.b != nil {
+			if got := tc.b.OverlapLength(tc.a); got != tc.want {
+				t.Errorf("want %s.OverlapLength(%s) = %d but get %d", tc.b, tc.a, tc.want, got)
+			}
+		}
+	}
+	if got := AtMost(1.5).OverlapLength(AtMost(0.0)); !math.IsInf(got, 1) {
+		t.Errorf("want +Inf for an unbounded overlap but get %v", got)
+	}
+	a, b := Closed(0.0, 10), Closed(2.5, 20)
+	if n := testing.AllocsPerRun(100, func() { a.OverlapLength(b) }); n != 0 {
+		t.Errorf("want no allocations but get %v", n)
+	}
+}
+
+func TestIntervalJaccard(t *testing.T) {
+	for _, tc := range []struct {
+		a, b IInterval[float64]
+		want float64
+	}{
+		{Closed(0.0, 10), Closed(5.0, 15), 1.0 / 3},
+		{Closed(0.0, 10), Closed(0.0, 10), 1},
+		{Closed(0.0, 10), Open(0.0, 10), 1},
+		{Closed(0.0, 10), Closed(2.0, 4), 0.2},
+		{Closed(0.0, 10), Closed(20.0, 30), 0},
+		{Point(3.0), Point(3.0), 1},
+		{Point(3.0), Point(4.0), 0},
+		{AtLeast(0.0), AtLeast(0.0), 1},
+		{AtLeast(0.0), AtLeast(1.0), 0},
+		{AtLeast(0.0), Closed(0.0, 1), 0},
+		{Open(1.0, 1), nil, 1},
+		{Closed(0.0, 1), nil, 0},
+	} {
+		if got := tc.a.Jaccard(tc.b); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("want %s.Jaccard(%v) = %v but get %v", tc.a, tc.b, tc.want, got)
+		}
+	}
+	if got := Closed(0, 4).Jaccard(Closed(2, 10)); got != 0.2 {
+		t.Errorf("want [0,4] and [2,10] of int to give 0.2 but get %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// ContainmentReason tells why a value is or is not in an interval.
+type ContainmentReason int
+
+const (
+	// Inside means the value is in the interval.
+	Inside ContainmentReason = iota
+	// BelowLower means the value is lower than the lower bound.
+	BelowLower
+	// AboveUpper means the value is higher than the upper bound.
+	AboveUpper
+	// AtExcludedLower means the value is the lower bound, which is not included.
+	AtExcludedLower
+	// AtExcludedUpper means the value is the upper bound, which is not included.
+	AtExcludedUpper
+	// InEmpty means the interval has no values at all.
+	InEmpty
+	// NotANumber means the value is NaN, which is in no interval.
+	NotANumber
+)
+
+// Containment is the reason a value is or is not in an interval, with the distance to the bound it misses for
+// BelowLower and AboveUpper.
+type Containment[T constraints.Integer | constraints.Float] struct {
+	Reason   ContainmentReason
+	Distance T
+}
+
+// Contained returns true if the value is in the interval.
+func (c Containment[T]) Contained() bool {
+	return c.Reason == Inside
+}
+
+func (c Containment[T]) String() string {
+	switch c.Reason {
+	case BelowLower:
+		return fmt.Sprintf("below lower bound by %v", c.Distance)
+	case AboveUpper:
+		return fmt.Sprintf("above upper bound by %v", c.Distance)
+	case AtExcludedLower:
+		return "at excluded lower bound"
+	case AtExcludedUpper:
+		return "at excluded upper bound"
+	case InEmpty:
+		return "interval is empty"
+	case NotANumber:
+		return "value is NaN"
+	}
+	return "inside"
+}
+
+// Explain returns why value is or is not in receiver interval, so a validation can report more than Has does.
+func (i *Interval[T]) Explain(value T) Containment[T] {
+	i = i.orEmpty()
+	if isNaN(value) {
+		return Containment[T]{Reason: NotANumber}
+	}
+	if i.IsEmpty() {
+		return Containment[T]{Reason: InEmpty}
+	}
+	if i.lower.Kind != Unbounded {
+		if value < i.lower.Value {
+			return Containment[T]{Reason: BelowLower, Distance: i.lower.Value - value}
+		}
+		if value == i.lower.Value && i.lower.Kind != ClosedBound {
+			return Containment[T]{Reason: AtExcludedLower}
+		}
+	}
+	if i.upper.Kind != Unbounded {
+		if value > i.upper.Value {
+			return Containment[T]{Reason: AboveUpper, Distance: value - i.upper.Value}
+		}
+		if value == i.upper.Value && i.upper.Kind != ClosedBound {
+			return Containment[T]{Reason: AtExcludedUpper}
+		}
+	}
+	return Containment[T]{Reason: Inside}
+}
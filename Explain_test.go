@@ -0,0 +1,53 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestIntervalExplain(t *testing.T) {
+	testIntervalExplain[int](t)
+	testIntervalExplain[float64](t)
+}
+
+// testIntervalExplain also checks that Explain agrees with Has on testsHAS.
+func testIntervalExplain[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsHAS {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.s)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			if c := i.Explain(T(tc.value)); c.Contained() != tc.result {
+				t.Errorf("want %s.Explain(%v) to be contained = %v (result conform test) but is %s, counter: %v",
+					i, tc.value, tc.result, c, tc.counter)
+			}
+		})
+	}
+	for n, tc := range testsIntervalExplain {
+		i, er := parseInterval[T](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if c := i.Explain(T(tc.value)); c.String() != tc.explanation {
+			t.Errorf("want %s.Explain(%v) = %s but is %s, counter: %v", i, tc.value, tc.explanation, c, n)
+		}
+	}
+}
+
+var testsIntervalExplain = []struct {
+	s           string
+	value       int
+	explanation string
+}{
+	{" *|---====|* ", 5, "inside"},
+	{" *|---====|* ", 1, "below lower bound by 2"},
+	{" *|---====|* ", 10, "above upper bound by 3"},
+	{" *|---====|* ", 3, "at excluded lower bound"},
+	{" *|---====|* ", 7, "at excluded upper bound"},
+	{" <|---====|> ", 100, "inside"},
+	{" *|---&|* ", 3, "interval is empty"},
+}
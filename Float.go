@@ -0,0 +1,44 @@
+package interval
+
+import (
+	"errors"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// For floating point types NaN and the infinities have a defined meaning as bounds:
+//
+//   - NaN is not a bound. NewIntervalChecked rejects it with ErrNaN; an interval made otherwise with a NaN bound is
+//     empty, and Has is false for a NaN value.
+//   - -Inf as lower bound and +Inf as upper bound mark an unbounded side, as if it was made unbounded.
+//   - +Inf as lower bound or -Inf as upper bound has no value beyond it, so the interval is empty.
+
+// ErrNaN is returned for a NaN bound.
+var ErrNaN = errors.New("interval: bound is NaN")
+
+func isNaN[T constraints.Integer | constraints.Float](v T) bool {
+	return v != v
+}
+
+// infinite returns 1 for +Inf, -1 for -Inf and 0 for every other value.
+func infinite[T constraints.Integer | constraints.Float](v T) int {
+	switch {
+	case discrete[T]():
+		return 0
+	case math.IsInf(float64(v), 1):
+		return 1
+	case math.IsInf(float64(v), -1):
+		return -1
+	}
+	return 0
+}
+
+// markInfinite makes the sides of receiver interval with bound -Inf or +Inf unbounded.
+func (i *Interval[T]) markInfinite() {
+	if infinite(i.lower.Value) < 0 {
+		i.lower.Kind = Unbounded
+	}
+	if infinite(i.upper.Value) > 0 {
+		i.upper.Kind = Unbounded
+	}
+}
@@ -0,0 +1,65 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntervalInfinite(t *testing.T) {
+	inf := math.Inf(1)
+	all := NewInterval(-inf, inf, true, false, true, false)
+	if !all.LowerUnbounded() || !all.UpperUnbounded() || all.LowerIncluded() || all.UpperIncluded() {
+		t.Errorf("want [-Inf, +Inf] to be unbounded on both sides but is %s", all)
+	}
+	atLeast := NewInterval(2, inf, true, false, true, false)
+	if !atLeast.Equal(NewInterval(2.0, 0, true, false, false, true)) {
+		t.Errorf("want [2, +Inf] to be [2, ...) but is %s", atLeast)
+	}
+	s := NewInterval(0.0, 5, true, false, false, false)
+	s.SetUpper(inf)
+	if !s.UpperUnbounded() {
+		t.Errorf("want SetUpper(+Inf) to make the upper side unbounded but is %s", s)
+	}
+	for _, e := range []*Interval[float64]{
+		NewInterval(inf, inf, true, false, true, false),
+		NewInterval(inf, 0, true, false, false, true),
+		NewInterval(0, -inf, false, true, true, false),
+	} {
+		if !e.IsEmpty() || e.Has(0) || e.Has(inf) || e.Overlaps(all) || !all.Contains(e) {
+			t.Errorf("want %s to be empty", e)
+		}
+	}
+	if !all.Has(inf) || !all.Has(1e308) || !atLeast.Has(inf) || atLeast.Has(-inf) {
+		t.Errorf("want the infinities to be in the unbounded sides")
+	}
+	if l, ok := atLeast.Length(); ok || !math.IsInf(l, 1) {
+		t.Errorf("want the length of %s to be +Inf, false but is %v, %v", atLeast, l, ok)
+	}
+	if i := atLeast.Intersect(NewInterval(0.0, 10, true, false, false, false)); i == nil || !i.Equal(NewInterval(2.0, 10, true, false, false, false)) {
+		t.Errorf("want %s.Intersect([0, 10)) = [2, 10) but is %v", atLeast, i)
+	}
+}
+
+func TestIntervalNaN(t *testing.T) {
+	nan := math.NaN()
+	all := NewInterval(0.0, 0, false, true, false, true)
+	if all.Has(nan) || NewInterval(0.0, 5, true, false, true, false).Has(nan) {
+		t.Errorf("want Has(NaN) to be false")
+	}
+	if c := all.Explain(nan); c.Reason != NotANumber || c.Contained() {
+		t.Errorf("want Explain(NaN) to be NotANumber but is %v", c)
+	}
+	n := NewInterval(nan, 5, true, false, true, false)
+	if !n.IsEmpty() || n.Has(1) || n.Overlaps(all) || !all.Contains(n) || n.Intersect(all) != nil {
+		t.Errorf("want %s to be empty", n)
+	}
+	if l, ok := n.Length(); l != 0 || !ok {
+		t.Errorf("want the length of %s to be 0, true but is %v, %v", n, l, ok)
+	}
+	if _, ok := n.Midpoint(); ok {
+		t.Errorf("want %s to have no midpoint", n)
+	}
+	if u := all.Union(n); len(u.Intervals()) != 1 {
+		t.Errorf("want the union with %s to leave %s but is %v", n, all, u)
+	}
+}
@@ -0,0 +1,92 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"strconv"
+	"strings"
+)
+
+// BracketStyle is how Format marks whether the bounds of an interval are included.
+type BracketStyle int
+
+const (
+	// StandardBrackets writes an included bound next to a square bracket and an excluded one next to a round
+	// bracket, as in [1,5).
+	StandardBrackets BracketStyle = iota
+	// ReversedBrackets writes an excluded bound next to a square bracket turned away from it, as ISO 31-11 does in
+	// [1,5[.
+	ReversedBrackets
+)
+
+// FormatOptions tells Format how to write an interval. The zero FormatOptions writes as String does.
+type FormatOptions struct {
+	Brackets BracketStyle
+	// Infinity is written for an unbounded side, after a '-' for the lower side and a '+' for the upper side. It is
+	// "∞" if empty.
+	Infinity string
+	// Separator is written between the bounds. It is "," if empty.
+	Separator string
+	// Empty is written for an empty interval. It is "empty" if empty.
+	Empty string
+	// Number writes a bound. If it is nil bounds are written as by fmt, or, for floating point types and a
+	// Precision above 0, with Precision digits after the decimal point.
+	Number    func(v any) string
+	Precision int
+}
+
+// Format returns receiver interval written as told by opts, like "]0, 2.50]" or "[1 .. +inf[".
+func (i *Interval[T]) Format(opts FormatOptions) string {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return orDefault(opts.Empty, "empty")
+	}
+	infinity := orDefault(opts.Infinity, "∞")
+	var b strings.Builder
+	switch {
+	case i.lower.Kind == ClosedBound:
+		b.WriteByte('[')
+	case opts.Brackets == ReversedBrackets:
+		b.WriteByte(']')
+	default:
+		b.WriteByte('(')
+	}
+	if i.lower.Kind == Unbounded {
+		b.WriteString("-" + infinity)
+	} else {
+		b.WriteString(formatNumber(i.lower.Value, opts))
+	}
+	b.WriteString(orDefault(opts.Separator, ","))
+	if i.upper.Kind == Unbounded {
+		b.WriteString("+" + infinity)
+	} else {
+		b.WriteString(formatNumber(i.upper.Value, opts))
+	}
+	switch {
+	case i.upper.Kind == ClosedBound:
+		b.WriteByte(']')
+	case opts.Brackets == ReversedBrackets:
+		b.WriteByte('[')
+	default:
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// formatNumber writes v as told by the Number and Precision of opts.
+func formatNumber[T constraints.Integer | constraints.Float](v T, opts FormatOptions) string {
+	switch {
+	case opts.Number != nil:
+		return opts.Number(v)
+	case opts.Precision > 0 && !discrete[T]():
+		return strconv.FormatFloat(float64(v), 'f', opts.Precision, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,28 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIntervalFormat(t *testing.T) {
+	for _, tc := range []struct {
+		i    IInterval[float64]
+		opts FormatOptions
+		want string
+	}{
+		{ClosedOpen(1.0, 5), FormatOptions{}, "[1,5)"},
+		{OpenClosed(0, 2.5), FormatOptions{Brackets: ReversedBrackets, Separator: ", ", Precision: 2}, "]0.00, 2.50]"},
+		{AtLeast(1.0), FormatOptions{Brackets: ReversedBrackets, Separator: " .. ", Infinity: "inf"}, "[1 .. +inf["},
+		{Less(3.0), FormatOptions{Infinity: "Inf"}, "(-Inf,3)"},
+		{Closed(1.0, 2), FormatOptions{Number: func(v any) string { return fmt.Sprintf("%.1e", v) }}, "[1.0e+00,2.0e+00]"},
+		{Open(1.0, 1), FormatOptions{Empty: "∅"}, "∅"},
+	} {
+		if s := tc.i.Format(tc.opts); s != tc.want {
+			t.Errorf("want %s.Format(%+v) = %s but get %s", tc.i, tc.opts, tc.want, s)
+		}
+	}
+	if s := Closed(1, 5).Format(FormatOptions{Precision: 2}); s != "[1,5]" {
+		t.Errorf("want Precision to leave integer bounds but get %s", s)
+	}
+}
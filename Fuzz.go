@@ -0,0 +1,68 @@
+package interval
+
+import (
+	"encoding/binary"
+	"golang.org/x/exp/constraints"
+	"math"
+)
+
+// AppendFuzzBytes appends a short byte string for x to dst, to be given as seed to a fuzz target which makes its
+// interval with FromFuzzBytes. The kinds of the sides take one byte, as in MarshalBinary, followed by the lower and
+// upper bound as varints for integer types and as 8 bytes big-endian for floating point types. A nil x is written as
+// the zero Interval.
+func AppendFuzzBytes[T constraints.Integer | constraints.Float](dst []byte, x IInterval[T]) []byte {
+	c := copyOf(x)
+	dst = append(dst, byte(c.lower.Kind)|byte(c.upper.Kind)<<4)
+	dst = appendFuzzValue(dst, c.lower.Value)
+	return appendFuzzValue(dst, c.upper.Value)
+}
+
+// FromFuzzBytes returns the interval written by AppendFuzzBytes at the beginning of data. Every byte string makes an
+// interval, so that a fuzzer which changes the seeds still reaches the code under test: missing bytes are taken as
+// zero and kinds beyond Unbounded wrap around. The bounds are not checked, so reversed bounds and, for floating point
+// types, infinite and NaN bounds are made as well.
+func FromFuzzBytes[T constraints.Integer | constraints.Float](data []byte) *Interval[T] {
+	var kinds byte
+	if len(data) > 0 {
+		kinds, data = data[0], data[1:]
+	}
+	lower, data := fuzzValue[T](data)
+	upper, _ := fuzzValue[T](data)
+	return &Interval[T]{
+		lower: Bound[T]{lower, BoundKind(kinds&0xf) % (Unbounded + 1)},
+		upper: Bound[T]{upper, BoundKind(kinds>>4) % (Unbounded + 1)},
+	}
+}
+
+// appendFuzzValue appends v to dst as a varint, zigzag encoded for signed integer types, or as its IEEE 754 bits.
+func appendFuzzValue[T constraints.Integer | constraints.Float](dst []byte, v T) []byte {
+	switch {
+	case !discrete[T]():
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(float64(v)))
+	case T(0)-1 > 0:
+		return binary.AppendUvarint(dst, uint64(v))
+	}
+	return binary.AppendVarint(dst, int64(v))
+}
+
+// fuzzValue returns the value written by appendFuzzValue at the beginning of data and the bytes after it. A value
+// which is cut off or does not fit in 64 bits is zero and takes the rest of data.
+func fuzzValue[T constraints.Integer | constraints.Float](data []byte) (T, []byte) {
+	if !discrete[T]() {
+		var b [8]byte
+		n := copy(b[:], data)
+		return T(math.Float64frombits(binary.BigEndian.Uint64(b[:]))), data[n:]
+	}
+	if T(0)-1 > 0 {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, nil
+		}
+		return T(v), data[n:]
+	}
+	v, n := binary.Varint(data)
+	if n <= 0 {
+		return 0, nil
+	}
+	return T(v), data[n:]
+}
@@ -0,0 +1,50 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFuzzBytes(t *testing.T) {
+	for _, x := range []IInterval[int]{Closed(-3, 5), OpenClosed(0, math.MaxInt), Less(-7), All[int](), nil} {
+		data := AppendFuzzBytes(nil, x)
+		if got := FromFuzzBytes[int](data); !got.Equal(OrEmpty(x)) {
+			t.Errorf("want %s from %x but get %s", x, data, got)
+		}
+	}
+	if data := AppendFuzzBytes(nil, Closed(1, 2)); len(data) != 3 {
+		t.Errorf("want 3 bytes for [1,2] but get %x", data)
+	}
+	for _, x := range []IInterval[float32]{ClosedOpen[float32](0.5, 2.25), Greater[float32](-1)} {
+		if got := FromFuzzBytes[float32](AppendFuzzBytes(nil, x)); !got.Equal(x) {
+			t.Errorf("want %s but get %s", x, got)
+		}
+	}
+	if got := FromFuzzBytes[uint8](AppendFuzzBytes(nil, IInterval[uint8](Closed[uint8](0, 255)))); !got.Equal(Closed[uint8](0, 255)) {
+		t.Errorf("want [0,255] of uint8 but get %s", got)
+	}
+	for _, data := range [][]byte{nil, {0xff}, {0x11, 0x80}, {0x22, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}} {
+		if x := FromFuzzBytes[int64](data); x.LowerBound().Kind > Unbounded || x.UpperBound().Kind > Unbounded {
+			t.Errorf("want valid bound kinds from %x but get %s", data, x)
+		}
+		if x := FromFuzzBytes[float64](data); x.LowerBound().Kind > Unbounded || x.UpperBound().Kind > Unbounded {
+			t.Errorf("want valid bound kinds from %x but get %s", data, x)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, x := range []IInterval[int]{Closed(-3, 5), Open(0, 0), AtLeast(4), All[int]()} {
+		f.Add(AppendFuzzBytes(nil, x))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		x := FromFuzzBytes[int](data)
+		got, er := Parse[int](x.String())
+		if er != nil {
+			t.Fatalf("want %s to parse but get %v", x, er)
+		}
+		if !got.EqualNormalized(x) {
+			t.Fatalf("want %s from parsing its string but get %s", x, got)
+		}
+	})
+}
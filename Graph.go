@@ -0,0 +1,76 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"sort"
+)
+
+// Graph is the overlap graph of a list of intervals. The vertices are the indexes in that list, two vertices are
+// connected when their intervals share at least one value.
+type Graph struct {
+	adjacent [][]int
+}
+
+// OverlapGraph builds the overlap graph of intervals with a sweep over the intervals ordered by their begin, so only
+// intervals which are still open at the begin of an interval are tested against it. Nil and empty intervals become
+// vertices without edges.
+func OverlapGraph[T constraints.Integer | constraints.Float](intervals []IInterval[T]) Graph {
+	g := Graph{adjacent: make([][]int, len(intervals))}
+	var active []int
+	for _, n := range sortedByLower(intervals) {
+		x := intervals[n]
+		if x == nil || x.IsEmpty() {
+			continue
+		}
+		open := active[:0]
+		for _, m := range active {
+			if !intervals[m].LtBeginOf(x) {
+				open = append(open, m)
+				g.adjacent[m] = append(g.adjacent[m], n)
+				g.adjacent[n] = append(g.adjacent[n], m)
+			}
+		}
+		active = append(open, n)
+	}
+	for _, a := range g.adjacent {
+		sort.Ints(a)
+	}
+	return g
+}
+
+// Len returns the number of vertices in the graph.
+func (g Graph) Len() int {
+	return len(g.adjacent)
+}
+
+// Neighbors returns the indexes of the intervals which overlap interval n, in ascending order.
+func (g Graph) Neighbors(n int) []int {
+	return append([]int(nil), g.adjacent[n]...)
+}
+
+// Degree returns the number of intervals which overlap interval n.
+func (g Graph) Degree(n int) int {
+	return len(g.adjacent[n])
+}
+
+// Edges returns every pair of overlapping intervals once, as index pairs with the lowest index first.
+func (g Graph) Edges() [][2]int {
+	var edges [][2]int
+	for n, a := range g.adjacent {
+		for _, m := range a {
+			if n < m {
+				edges = append(edges, [2]int{n, m})
+			}
+		}
+	}
+	return edges
+}
+
+// AdjacencyList exports the graph as a plain adjacency list, in which element n holds the neighbors of vertex n.
+func (g Graph) AdjacencyList() [][]int {
+	list := make([][]int, len(g.adjacent))
+	for n := range g.adjacent {
+		list[n] = g.Neighbors(n)
+	}
+	return list
+}
@@ -0,0 +1,64 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"reflect"
+	"testing"
+)
+
+func TestOverlapGraph(t *testing.T) {
+	testOverlapGraph[int](t)
+	testOverlapGraph[float64](t)
+}
+
+func testOverlapGraph[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsOverlapGraph {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var intervals []IInterval[T]
+			for _, s := range tc.intervals {
+				x, er := parseInterval[T](s)
+				if er != nil {
+					t.Errorf(er.Error())
+					return
+				}
+				if x == nil {
+					intervals = append(intervals, nil)
+					continue
+				}
+				intervals = append(intervals, x)
+			}
+			g := OverlapGraph(intervals)
+			if a := g.AdjacencyList(); !reflect.DeepEqual(a, tc.adjacent) {
+				t.Errorf("want OverlapGraph(%v) = %v but is %v, counter: %v", intervals, tc.adjacent, a, tc.counter)
+			}
+		})
+	}
+}
+
+var testsOverlapGraph = []struct {
+	intervals []string
+	adjacent  [][]int
+	counter   string
+}{
+	{
+		intervals: []string{"  |====|  ", "  |----====|  ", "  |--------====|  "},
+		adjacent:  [][]int{{1}, {0, 2}, {1}},
+		counter:   "0",
+	},
+	{
+		intervals: []string{"  |====|* ", "  |----====|* ", "  |--------====|* "},
+		adjacent:  [][]int{nil, nil, nil},
+		counter:   "1",
+	},
+	{
+		intervals: []string{"  |--------====|  ", " <|=====|  ", "  |---=========|> ", "  |-=|  "},
+		adjacent:  [][]int{{2}, {2, 3}, {0, 1}, {1}},
+		counter:   "2",
+	},
+	{
+		intervals: []string{"  |============|  ", "", " *|---&|* ", "  |----====|  "},
+		adjacent:  [][]int{{3}, nil, nil, {0}},
+		counter:   "3",
+	},
+}
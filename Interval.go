@@ -1,11 +1,13 @@
 package interval
 
 import (
-	"fmt"
 	"golang.org/x/exp/constraints"
-	"strings"
+	"iter"
+	"math"
 )
 
+// IInterval is the interface of Interval. nil, a nil *Interval and every empty interval are the empty set; methods
+// which have no interval to return return nil.
 type IInterval[T constraints.Integer | constraints.Float] interface {
 	Lower() T
 	SetLower(lower T)
@@ -19,269 +21,495 @@ type IInterval[T constraints.Integer | constraints.Float] interface {
 	SetLowerIncluded(lowerIncluded bool)
 	UpperIncluded() bool
 	SetUpperIncluded(upperIncluded bool)
+	LowerBound() Bound[T]
+	UpperBound() Bound[T]
+	LowerValue() (T, bool)
+	UpperValue() (T, bool)
 	String() string
+	Format(opts FormatOptions) string
+	Clone() IInterval[T]
+	WithLower(lower T, included bool) IInterval[T]
+	WithUpper(upper T, included bool) IInterval[T]
+	WithLowerUnbounded() IInterval[T]
+	WithUpperUnbounded() IInterval[T]
 	Equal(x IInterval[T]) bool
+	Compare(x IInterval[T]) int
+	Key() Key[T]
+	Normalize() IInterval[T]
+	EqualNormalized(x IInterval[T]) bool
+	EqualWithin(x IInterval[T], eps T) bool
+	ToClosed() IInterval[T]
+	ToHalfOpen() IInterval[T]
 	IsEmpty() bool
+	IsPoint() bool
+	Length() (T, bool)
+	Midpoint() (T, bool)
 	LtBeginOf(x IInterval[T]) bool
 	LeEndOf(x IInterval[T]) bool
 	Contains(x IInterval[T]) bool
+	Overlaps(x IInterval[T]) bool
+	Disjoint(x IInterval[T]) bool
+	Abuts(x IInterval[T]) bool
+	Relate(x IInterval[T]) Relation
+	Before(x IInterval[T]) bool
+	After(x IInterval[T]) bool
+	Meets(x IInterval[T]) bool
+	MetBy(x IInterval[T]) bool
+	Starts(x IInterval[T]) bool
+	During(x IInterval[T]) bool
+	Finishes(x IInterval[T]) bool
 	Has(value T) bool
+	HasWithin(value T, eps T) bool
+	DistanceTo(value T) T
+	Explain(value T) Containment[T]
 	Intersect(x IInterval[T]) IInterval[T]
+	OverlapLength(x IInterval[T]) T
+	Jaccard(x IInterval[T]) float64
+	ClampTo(window IInterval[T]) IInterval[T]
 	Move(x T) IInterval[T]
+	Map(f func(T) T, increasing bool) IInterval[T]
+	Expand(lowerPad, upperPad T) IInterval[T]
 	Subtract(x IInterval[T]) (IInterval[T], IInterval[T])
+	SubtractAll(x IInterval[T]) []IInterval[T]
 	Adjoin(x IInterval[T]) IInterval[T]
+	Gap(x IInterval[T]) IInterval[T]
 	Encompass(x IInterval[T]) IInterval[T]
+	Meet(x IInterval[T]) IInterval[T]
+	Join(x IInterval[T]) IInterval[T]
+	SplitAt(point T, side CutSide) (IInterval[T], IInterval[T])
+	SplitAtAll(points []T, side CutSide) []IInterval[T]
+	Partition(points ...T) []IInterval[T]
+	Chunks(maxChunk T) iter.Seq[IInterval[T]]
+	Values() iter.Seq[T]
+	Iterate(step T) iter.Seq[T]
+	SampleN(n int) []T
+	Lerp(t float64) T
+	InvLerp(v T) float64
+	Union(x IInterval[T]) *IntervalSet[T]
 }
 
+// Interval is an interval of values of T, with a lower and an upper side which are each bounded or unbounded, and
+// when bounded include their bound or not. The zero value is the empty interval (0, 0), on which every method works.
 type Interval[T constraints.Integer | constraints.Float] struct {
-	// begin of this interval.
-	lower T
-	// if lowerIncluded is true, this interval is inclusive of the lower point.
-	lowerIncluded bool
-	// if lowerUnbounded is true, the value of lower is ignored and the interval is considered endles on the lowerside
-	lowerUnbounded bool
-	// end of this interval.
-	upper T
-	// if upperIncluded is true, this interval is inclusive of the upper point.
-	upperIncluded bool
-	// if upperUnbounded is true, the value of upper is ignored and the interval is considered endles on the upperside
-	upperUnbounded bool
+	// begin of this interval, included or not, or unbounded, in which case its value is ignored.
+	lower Bound[T]
+	// end of this interval, included or not, or unbounded, in which case its value is ignored.
+	upper Bound[T]
 }
 
 func NewInterval[T constraints.Integer | constraints.Float](lower, upper T, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool) *Interval[T] {
 	interval := new(Interval[T])
-	interval.lower = lower
-	interval.upper = upper
-	interval.lowerIncluded = lowerIncluded
-	interval.upperIncluded = upperIncluded
-	interval.lowerUnbounded = lowerUnbounded
-	interval.upperUnbounded = upperUnbounded
+	interval.lower = newBound(lower, lowerIncluded, lowerUnbounded)
+	interval.upper = newBound(upper, upperIncluded, upperUnbounded)
+	interval.markInfinite()
 	return interval
 }
 
+// Point returns the interval [v, v], which has only the value v.
+func Point[T constraints.Integer | constraints.Float](v T) *Interval[T] {
+	return NewInterval[T](v, v, true, false, true, false)
+}
+
 func (i *Interval[T]) Lower() T {
-	return i.lower
+	i = i.orEmpty()
+	return i.lower.Value
 }
 
 func (i *Interval[T]) SetLower(lower T) {
-	i.lower = lower
+	i.lower.Value = lower
+	i.markInfinite()
 }
 
 func (i *Interval[T]) Upper() T {
-	return i.upper
+	i = i.orEmpty()
+	return i.upper.Value
 }
 
 func (i *Interval[T]) SetUpper(upper T) {
-	i.upper = upper
+	i.upper.Value = upper
+	i.markInfinite()
 }
 
 func (i *Interval[T]) LowerUnbounded() bool {
-	return i.lowerUnbounded
+	i = i.orEmpty()
+	return i.lower.Kind == Unbounded
 }
 
 func (i *Interval[T]) SetLowerUnbounded(lowerUnbounded bool) {
-	i.lowerUnbounded = lowerUnbounded
+	i.lower.setUnbounded(lowerUnbounded)
 }
 
 func (i *Interval[T]) UpperUnbounded() bool {
-	return i.upperUnbounded
+	i = i.orEmpty()
+	return i.upper.Kind == Unbounded
 }
 
 func (i *Interval[T]) SetUpperUnbounded(upperUnbounded bool) {
-	i.upperUnbounded = upperUnbounded
+	i.upper.setUnbounded(upperUnbounded)
 }
 
 func (i *Interval[T]) LowerIncluded() bool {
-	return i.lowerIncluded
+	i = i.orEmpty()
+	return i.lower.Kind == ClosedBound
 }
 
 func (i *Interval[T]) SetLowerIncluded(lowerIncluded bool) {
-	i.lowerIncluded = lowerIncluded
+	i.lower.setIncluded(lowerIncluded)
 }
 
 func (i *Interval[T]) UpperIncluded() bool {
-	return i.upperIncluded
+	i = i.orEmpty()
+	return i.upper.Kind == ClosedBound
 }
 
 func (i *Interval[T]) SetUpperIncluded(upperIncluded bool) {
-	i.upperIncluded = upperIncluded
+	i.upper.setIncluded(upperIncluded)
 }
 
+// String returns receiver interval in mathematical notation, like [1,5), (-∞,3] or (2.5,7.25), with an unbounded
+// side written as -∞ or +∞. Every empty interval is written as "empty". Use Format to write it otherwise.
 func (i *Interval[T]) String() string {
-	var b strings.Builder
-	if i.lowerUnbounded {
-		b.WriteByte('<')
+	return i.Format(FormatOptions{})
+}
+
+// Clone returns a copy of receiver interval, which can be changed without changing receiver interval.
+func (i *Interval[T]) Clone() IInterval[T] {
+	return copyOf[T](i)
+}
+
+// WithLower returns a copy of receiver interval with lower bound lower, included or not.
+func (i *Interval[T]) WithLower(lower T, included bool) IInterval[T] {
+	c := copyOf[T](i)
+	c.lower = newBound(lower, included, false)
+	return c
+}
+
+// WithUpper returns a copy of receiver interval with upper bound upper, included or not.
+func (i *Interval[T]) WithUpper(upper T, included bool) IInterval[T] {
+	c := copyOf[T](i)
+	c.upper = newBound(upper, included, false)
+	return c
+}
+
+// WithLowerUnbounded returns a copy of receiver interval without a lower bound.
+func (i *Interval[T]) WithLowerUnbounded() IInterval[T] {
+	c := copyOf[T](i)
+	c.lower = Bound[T]{Kind: Unbounded}
+	return c
+}
+
+// WithUpperUnbounded returns a copy of receiver interval without an upper bound.
+func (i *Interval[T]) WithUpperUnbounded() IInterval[T] {
+	c := copyOf[T](i)
+	c.upper = Bound[T]{Kind: Unbounded}
+	return c
+}
+
+// Equal returns true if receiver interval is equals x_interval_string interval. All empty intervals, and nil, are
+// equal.
+func (i *Interval[T]) Equal(x IInterval[T]) bool {
+	return i.Key() == copyOf(x).Key()
+}
+
+// Normalize returns receiver interval in canonical form, or nil if it is empty. For integer types excluded bounds
+// become the included integers next to them, so (2, 5) and [3, 4] both become [3, 4]. For floating point types it
+// returns a copy.
+func (i *Interval[T]) Normalize() IInterval[T] {
+	if i.IsEmpty() {
+		return nil
 	}
-	if i.lowerIncluded {
-		b.WriteByte('[')
-	} else {
-		b.WriteByte('(')
+	if discrete[T]() {
+		return closed[T](i)
 	}
-	fmt.Fprintf(&b, "%v", i.lower)
-	b.WriteString(", ")
-	fmt.Fprintf(&b, "%v", i.upper)
-	if i.upperIncluded {
-		b.WriteByte(']')
-	} else {
-		b.WriteByte(')')
+	return copyOf[T](i)
+}
+
+// EqualNormalized returns true if receiver interval and x_interval_string interval are equal in canonical form, as
+// returned by Normalize, so for integer types if they have the same values. Two empty intervals are equal.
+func (i *Interval[T]) EqualNormalized(x IInterval[T]) bool {
+	if x == nil {
+		return i.IsEmpty()
 	}
-	if i.upperUnbounded {
-		b.WriteByte('>')
+	n, m := i.Normalize(), x.Normalize()
+	if n == nil || m == nil {
+		return n == nil && m == nil
 	}
-	return b.String()
+	return n.Equal(m)
 }
 
-// Equal returns true if receiver interval is equals x_interval_string interval.
-func (i *Interval[T]) Equal(x IInterval[T]) bool {
-	if x == nil {
-		return false
+// ToClosed returns receiver interval with included bounds, like [3, 4] for (2, 5), or nil if it is empty. It is
+// Normalize, and like it returns a copy for floating point types.
+func (i *Interval[T]) ToClosed() IInterval[T] {
+	return i.Normalize()
+}
+
+// ToHalfOpen returns receiver interval with an included lower and an excluded upper bound, like [3, 5) for (2, 4],
+// or nil if it is empty. Unbounded sides are kept, and an upper bound which is the highest value of T stays
+// included. For floating point types, where no half-open interval holds the same values, it returns a copy.
+func (i *Interval[T]) ToHalfOpen() IInterval[T] {
+	if i.IsEmpty() {
+		return nil
 	}
-	if x.UpperUnbounded() && i.upperUnbounded {
-		return (i.lower == x.Lower() &&
-			i.lowerIncluded == x.LowerIncluded() &&
-			i.lowerUnbounded == x.LowerUnbounded()) || (x.IsEmpty() && i.IsEmpty())
+	if !discrete[T]() {
+		return copyOf[T](i)
 	}
-	if x.LowerUnbounded() && i.lowerUnbounded {
-		return (i.upper == x.Upper() &&
-			i.upperIncluded == x.UpperIncluded() &&
-			i.upperUnbounded == x.UpperUnbounded()) || (x.IsEmpty() && i.IsEmpty())
+	c := closed[T](i)
+	if c.upper.Kind != Unbounded && c.upper.Value+1 > c.upper.Value {
+		c.upper.Value++
+		c.upper.Kind = OpenBound
 	}
-	return (i.lower == x.Lower() &&
-		i.upper == x.Upper() &&
-		i.lowerIncluded == x.LowerIncluded() &&
-		i.upperIncluded == x.UpperIncluded() &&
-		i.lowerUnbounded == x.LowerUnbounded() &&
-		i.upperUnbounded == x.UpperUnbounded()) || (x.IsEmpty() && i.IsEmpty())
+	return c
 }
 
-// IsEmpty returns true if receiver interval has no value.
+// Compare orders receiver interval against x_interval_string interval: -1 if it sorts first, 0 if they have the same
+// bounds and 1 if it sorts last. Intervals sort by their begin, then by their end, where an unbounded lower side
+// begins first, an included lower bound begins before an excluded one, an excluded upper bound ends before an
+// included one and an unbounded upper side ends last. nil sorts first. Use it with slices.SortFunc.
+func (i *Interval[T]) Compare(x IInterval[T]) int {
+	if x == nil {
+		return 1
+	}
+	if c := compareLower[T](i, x); c != 0 {
+		return c
+	}
+	return compareUpper[T](i, x)
+}
+
+// IsEmpty returns true if receiver interval has no value. For integer types that includes an open interval between
+// two consecutive integers, like (3, 4).
 func (i *Interval[T]) IsEmpty() bool {
-	if i.upperUnbounded || i.lowerUnbounded {
-		return false
+	i = i.orEmpty()
+	if i.lower.Kind != Unbounded && (isNaN(i.lower.Value) || infinite(i.lower.Value) > 0) || i.upper.Kind != Unbounded && (isNaN(i.upper.Value) || infinite(i.upper.Value) < 0) {
+		return true
 	}
-	if i.lower < i.upper {
+	if i.upper.Kind == Unbounded || i.lower.Kind == Unbounded {
 		return false
-	} else if i.lower == i.upper {
-		return !i.lowerIncluded || !i.upperIncluded
+	}
+	if i.lower.Value < i.upper.Value {
+		return discrete[T]() && i.lower.Kind != ClosedBound && i.upper.Kind != ClosedBound && i.lower.Value+1 == i.upper.Value
+	} else if i.lower.Value == i.upper.Value {
+		return i.lower.Kind != ClosedBound || i.upper.Kind != ClosedBound
 	}
 	return true
 }
 
+// IsPoint returns true if receiver interval has exactly one value: [v, v], or for integer types also an interval
+// like (3, 5) or [4, 5).
+func (i *Interval[T]) IsPoint() bool {
+	i = i.orEmpty()
+	if i.lower.Kind == Unbounded || i.upper.Kind == Unbounded || i.IsEmpty() {
+		return false
+	}
+	if i.lower.Value == i.upper.Value {
+		return true
+	}
+	if !discrete[T]() {
+		return false
+	}
+	lower, upper := i.lower.Value, i.upper.Value
+	if i.lower.Kind != ClosedBound {
+		lower++
+	}
+	if i.upper.Kind != ClosedBound {
+		upper--
+	}
+	return lower == upper
+}
+
+// Length returns upper - lower of receiver interval, or 0 if it is empty. If a side is unbounded ok is false and
+// the length is +Inf for floating point types and 0 for integer types. ok is also false, with length 0, for an
+// integer interval longer than the highest value of T, like [MinInt64, MaxInt64].
+func (i *Interval[T]) Length() (length T, ok bool) {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return 0, true
+	}
+	if i.lower.Kind == Unbounded || i.upper.Kind == Unbounded {
+		if discrete[T]() {
+			return 0, false
+		}
+		return T(math.Inf(1)), false
+	}
+	if length, overflow := sub(i.upper.Value, i.lower.Value); overflow == 0 {
+		return length, true
+	}
+	return 0, false
+}
+
+// Midpoint returns the value halfway between lower and upper of receiver interval, rounded down for integer types.
+// It is computed without overflow, also for intervals spanning the whole range of T. ok is false for an empty or
+// unbounded interval.
+func (i *Interval[T]) Midpoint() (midpoint T, ok bool) {
+	i = i.orEmpty()
+	if i.IsEmpty() || i.lower.Kind == Unbounded || i.upper.Kind == Unbounded {
+		return 0, false
+	}
+	halfLower, halfUpper := i.lower.Value/2, i.upper.Value/2
+	if !discrete[T]() {
+		return halfLower + halfUpper, true
+	}
+	// Add half of what the integer divisions dropped, -2 to 2, rounding down.
+	dropped := i.lower.Value - 2*halfLower + i.upper.Value - 2*halfUpper
+	midpoint = halfLower + halfUpper + dropped/2
+	if dropped < 0 && dropped/2*2 != dropped {
+		midpoint--
+	}
+	return midpoint, true
+}
+
 // LtBeginOf returns true if receiver interval is less than begin of x_interval_string interval.
 func (i *Interval[T]) LtBeginOf(x IInterval[T]) bool {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() {
 		return false
 	}
 	if i.IsEmpty() {
 		return false
 	}
-	if i.upperUnbounded {
+	if i.upper.Kind == Unbounded {
 		return false
 	}
 	if x.LowerUnbounded() {
 		return false
 	}
-	if i.upper < x.Lower() {
+	if i.upper.Value < x.Lower() {
 		return true
-	} else if i.upper == x.Lower() {
-		return !i.upperIncluded || !x.LowerIncluded()
+	} else if i.upper.Value == x.Lower() {
+		return i.upper.Kind != ClosedBound || !x.LowerIncluded()
 	}
 	return false
 }
 
 // LeEndOf returns true if receiver interval is less than or equal to end of x_interval_string interval.
 func (i *Interval[T]) LeEndOf(x IInterval[T]) bool {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() {
 		return false
 	}
 	if i.IsEmpty() {
 		return false
 	}
-	if i.upperUnbounded {
+	if i.upper.Kind == Unbounded {
 		return false
 	} else if x.UpperUnbounded() {
 		return true
 	}
-	if i.upper < x.Upper() {
+	if i.upper.Value < x.Upper() {
 		return true
 	}
-	if i.upper == x.Upper() {
-		return (i.upperIncluded && x.UpperIncluded()) || !i.upperIncluded
+	if i.upper.Value == x.Upper() {
+		return (i.upper.Kind == ClosedBound && x.UpperIncluded()) || i.upper.Kind != ClosedBound
 	}
 	return false
 }
 
 // Contains returns true if x_interval_string interval is completely covered by receiver interval.
 func (i *Interval[T]) Contains(x IInterval[T]) bool {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() {
 		return true
 	}
 	if i.IsEmpty() {
 		return false
 	}
+	if discrete[T]() {
+		// [3, 4) and [3, 3] hold the same integers, so compare included bounds.
+		i, x = closed[T](i), closed(x)
+	}
 	lowerSide := false
 	upperSide := false
-	if x.LowerUnbounded() && !i.lowerUnbounded {
+	if x.LowerUnbounded() && i.lower.Kind != Unbounded {
 		lowerSide = false
 	} else {
-		if i.lowerUnbounded {
+		if i.lower.Kind == Unbounded {
 			lowerSide = true
 		}
-		if i.lower < x.Lower() {
+		if i.lower.Value < x.Lower() {
 			lowerSide = true
 		}
-		if i.lower == x.Lower() && !x.LowerIncluded() {
+		if i.lower.Value == x.Lower() && !x.LowerIncluded() {
 			lowerSide = true
 		}
-		if i.lower == x.Lower() && i.lowerIncluded {
+		if i.lower.Value == x.Lower() && i.lower.Kind == ClosedBound {
 			lowerSide = true
 		}
 	}
-	if x.UpperUnbounded() && !i.upperUnbounded {
+	if x.UpperUnbounded() && i.upper.Kind != Unbounded {
 		upperSide = false
 	} else {
-		if i.upperUnbounded {
+		if i.upper.Kind == Unbounded {
 			upperSide = true
 		}
-		if i.upper > x.Upper() {
+		if i.upper.Value > x.Upper() {
 			upperSide = true
 		}
-		if i.upper == x.Upper() && !x.UpperIncluded() {
+		if i.upper.Value == x.Upper() && !x.UpperIncluded() {
 			upperSide = true
 		}
-		if i.upper == x.Upper() && i.upperIncluded {
+		if i.upper.Value == x.Upper() && i.upper.Kind == ClosedBound {
 			upperSide = true
 		}
 	}
 	return lowerSide && upperSide
 }
 
+// Overlaps returns true if receiver interval and x_interval_string interval have at least one value in common.
+func (i *Interval[T]) Overlaps(x IInterval[T]) bool {
+	if x == nil || x.IsEmpty() || i.IsEmpty() {
+		return false
+	}
+	return !i.LtBeginOf(x) && !x.LtBeginOf(i)
+}
+
+// Disjoint returns true if receiver interval and x_interval_string interval have no value in common.
+func (i *Interval[T]) Disjoint(x IInterval[T]) bool {
+	return !i.Overlaps(x)
+}
+
+// Abuts returns true if receiver interval and x_interval_string interval touch without overlapping: the end of one is
+// the begin of the other, with that value in exactly one of them, like [0, 4] and (4, 8]. Integer intervals also abut
+// when no integer lies between them, like [0, 4] and [5, 8].
+func (i *Interval[T]) Abuts(x IInterval[T]) bool {
+	if x == nil || x.IsEmpty() || i.IsEmpty() {
+		return false
+	}
+	a, b := included[T](i), included(x)
+	if compareLower(b, a) < 0 {
+		a, b = b, a
+	}
+	return compareUpper(a, b) < 0 && partitionBoundary(a, b) == 0
+}
+
 func (i *Interval[T]) Has(value T) bool {
-	if i.lowerUnbounded && i.upperUnbounded {
+	i = i.orEmpty()
+	if isNaN(value) || i.IsEmpty() {
+		return false
+	}
+	if i.lower.Kind == Unbounded && i.upper.Kind == Unbounded {
 		return true
 	}
 	returnValue := true
-	if !i.lowerUnbounded && i.upperUnbounded {
-		if i.lowerIncluded {
-			returnValue = value >= i.lower
+	if i.lower.Kind != Unbounded && i.upper.Kind == Unbounded {
+		if i.lower.Kind == ClosedBound {
+			returnValue = value >= i.lower.Value
 		} else {
-			returnValue = value > i.lower
+			returnValue = value > i.lower.Value
 		}
-	} else if !i.upperUnbounded && i.lowerUnbounded {
-		if i.upperIncluded {
-			returnValue = value <= i.upper
+	} else if i.upper.Kind != Unbounded && i.lower.Kind == Unbounded {
+		if i.upper.Kind == ClosedBound {
+			returnValue = value <= i.upper.Value
 		} else {
-			returnValue = value < i.upper
+			returnValue = value < i.upper.Value
 		}
-	} else if !i.lowerUnbounded && !i.upperUnbounded {
-		if i.lowerIncluded && i.upperIncluded {
-			returnValue = value >= i.lower && value <= i.upper
-		} else if !i.lowerIncluded && i.upperIncluded {
-			returnValue = value > i.lower && value <= i.upper
-		} else if i.lowerIncluded && !i.upperIncluded {
-			returnValue = value >= i.lower && value < i.upper
-		} else if !i.lowerIncluded && !i.upperIncluded {
-			returnValue = value > i.lower && value < i.upper
+	} else if i.lower.Kind != Unbounded && i.upper.Kind != Unbounded {
+		if i.lower.Kind == ClosedBound && i.upper.Kind == ClosedBound {
+			returnValue = value >= i.lower.Value && value <= i.upper.Value
+		} else if i.lower.Kind != ClosedBound && i.upper.Kind == ClosedBound {
+			returnValue = value > i.lower.Value && value <= i.upper.Value
+		} else if i.lower.Kind == ClosedBound && i.upper.Kind != ClosedBound {
+			returnValue = value >= i.lower.Value && value < i.upper.Value
+		} else if i.lower.Kind != ClosedBound && i.upper.Kind != ClosedBound {
+			returnValue = value > i.lower.Value && value < i.upper.Value
 		}
 	}
 	return returnValue
@@ -289,6 +517,7 @@ func (i *Interval[T]) Has(value T) bool {
 
 // Intersect returns the intersection of receiver interval with x_interval_string interval.
 func (i *Interval[T]) Intersect(x IInterval[T]) IInterval[T] {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() || i.IsEmpty() {
 		return nil
 	}
@@ -300,33 +529,48 @@ func (i *Interval[T]) Intersect(x IInterval[T]) IInterval[T] {
 		x.UpperIncluded(),
 		x.UpperUnbounded(),
 	)
-	if !i.lowerUnbounded && !x.LowerUnbounded() {
-		if i.lower > x.Lower() {
-			r.SetLower(i.lower)
-			r.SetLowerIncluded(i.lowerIncluded)
-		} else if i.lower == x.Lower() && !i.lowerIncluded {
+	if i.lower.Kind != Unbounded && !x.LowerUnbounded() {
+		if i.lower.Value > x.Lower() {
+			r.lower = i.lower
+		} else if i.lower.Value == x.Lower() && i.lower.Kind != ClosedBound {
 			r.SetLowerIncluded(false)
 		}
-	} else if x.LowerUnbounded() && !i.lowerUnbounded {
-		r.SetLower(i.lower)
-		r.SetLowerIncluded(i.lowerIncluded)
-		r.SetLowerUnbounded(false)
-	}
-	if !i.upperUnbounded && !x.UpperUnbounded() {
-		if i.upper < x.Upper() {
-			r.SetUpper(i.upper)
-			r.SetUpperIncluded(i.upperIncluded)
-		} else if i.upper == x.Upper() && !i.upperIncluded {
+	} else if x.LowerUnbounded() && i.lower.Kind != Unbounded {
+		r.lower = i.lower
+	}
+	if i.upper.Kind != Unbounded && !x.UpperUnbounded() {
+		if i.upper.Value < x.Upper() {
+			r.upper = i.upper
+		} else if i.upper.Value == x.Upper() && i.upper.Kind != ClosedBound {
 			r.SetUpperIncluded(false)
 		}
-	} else if x.UpperUnbounded() && !i.upperUnbounded {
-		r.SetUpper(i.upper)
-		r.SetUpperIncluded(i.upperIncluded)
-		r.SetUpperUnbounded(false)
+	} else if x.UpperUnbounded() && i.upper.Kind != Unbounded {
+		r.upper = i.upper
 	}
 	return maybeEmpty(r)
 }
 
+// ClampTo returns receiver interval restricted to window. Unlike Intersect it never returns nil: when they have no
+// value in common the result is an empty interval [v, v) at the edge of window nearest to receiver interval.
+func (i *Interval[T]) ClampTo(window IInterval[T]) IInterval[T] {
+	i = i.orEmpty()
+	if r := i.Intersect(window); r != nil {
+		return r
+	}
+	v := i.lower.Value
+	switch {
+	case window == nil || window.IsEmpty():
+		if window != nil {
+			v = window.Lower()
+		}
+	case !i.IsEmpty() && compareLower(i, window) < 0, i.IsEmpty() && !window.LowerUnbounded() && v < window.Lower():
+		v = window.Lower()
+	case !i.IsEmpty(), !window.UpperUnbounded() && v > window.Upper():
+		v = window.Upper()
+	}
+	return NewInterval(v, v, true, false, false, false)
+}
+
 func maybeEmpty[T constraints.Integer | constraints.Float](x *Interval[T]) IInterval[T] {
 	if x == nil || x.IsEmpty() {
 		return nil
@@ -334,19 +578,138 @@ func maybeEmpty[T constraints.Integer | constraints.Float](x *Interval[T]) IInte
 	return x
 }
 
-// Move returns an interval that adds number x_interval_string to begin and end of receiver interval.
+// OrEmpty returns x, or the empty interval if x is nil, so operations can be chained without nil checks, like
+// OrEmpty(a.Intersect(b)).Intersect(c).
+func OrEmpty[T constraints.Integer | constraints.Float](x IInterval[T]) IInterval[T] {
+	if x == nil {
+		return Empty[T]()
+	}
+	return x
+}
+
+// orEmpty returns receiver interval, or the empty zero Interval if it is nil, so a nil *Interval is the empty set.
+func (i *Interval[T]) orEmpty() *Interval[T] {
+	if i == nil {
+		return new(Interval[T])
+	}
+	return i
+}
+
+// discrete returns true if T is an integer type, which has no values between consecutive integers.
+func discrete[T constraints.Integer | constraints.Float]() bool {
+	half := 0.5
+	return T(half) == 0
+}
+
+// copyOf returns a new interval with the same bounds as x, or the empty interval if x is nil.
+func copyOf[T constraints.Integer | constraints.Float](x IInterval[T]) *Interval[T] {
+	if x == nil {
+		return new(Interval[T])
+	}
+	return NewInterval[T](x.Lower(), x.Upper(), x.LowerIncluded(), x.LowerUnbounded(), x.UpperIncluded(), x.UpperUnbounded())
+}
+
+// closed returns a copy of x with its excluded bounds replaced by the included integers next to them, for integer
+// types, as (3, 6) by [4, 5].
+func closed[T constraints.Integer | constraints.Float](x IInterval[T]) *Interval[T] {
+	c := copyOf(x)
+	if c.lower.Kind != Unbounded && c.lower.Kind != ClosedBound && c.lower.Value+1 > c.lower.Value {
+		c.lower = Bound[T]{c.lower.Value + 1, ClosedBound}
+	}
+	if c.upper.Kind != Unbounded && c.upper.Kind != ClosedBound && c.upper.Value-1 < c.upper.Value {
+		c.upper = Bound[T]{c.upper.Value - 1, ClosedBound}
+	}
+	return c
+}
+
+// span returns a new interval from the lowest begin to the highest end of a and b, without changing a or b.
+func span[T constraints.Integer | constraints.Float](a, b IInterval[T]) *Interval[T] {
+	r := copyOf(a)
+	if compareLower(b, a) < 0 {
+		r.lower = b.LowerBound()
+	}
+	if compareUpper(b, a) > 0 {
+		r.upper = b.UpperBound()
+	}
+	return r
+}
+
+// mergeable returns true if a and b overlap or adjoin, so that together they cover one interval, which for integer
+// types includes intervals without an integer between them.
+func mergeable[T constraints.Integer | constraints.Float](a, b IInterval[T]) bool {
+	if compareLower(b, a) < 0 {
+		a, b = b, a
+	}
+	return partitionBoundary(a, b) >= 0
+}
+
+// compareLower orders the begin of a against the begin of b: -1 if a begins earlier, 0 if they begin at the same point.
+// An unbounded lower side begins before every value, an included lower bound before an excluded one.
+func compareLower[T constraints.Integer | constraints.Float](a, b IInterval[T]) int {
+	switch {
+	case a.LowerUnbounded() && b.LowerUnbounded():
+		return 0
+	case a.LowerUnbounded():
+		return -1
+	case b.LowerUnbounded():
+		return 1
+	case a.Lower() < b.Lower():
+		return -1
+	case a.Lower() > b.Lower():
+		return 1
+	case a.LowerIncluded() == b.LowerIncluded():
+		return 0
+	case a.LowerIncluded():
+		return -1
+	}
+	return 1
+}
+
+// compareUpper orders the end of a against the end of b: -1 if a ends earlier, 0 if they end at the same point.
+// An unbounded upper side ends after every value, an excluded upper bound before an included one.
+func compareUpper[T constraints.Integer | constraints.Float](a, b IInterval[T]) int {
+	switch {
+	case a.UpperUnbounded() && b.UpperUnbounded():
+		return 0
+	case a.UpperUnbounded():
+		return 1
+	case b.UpperUnbounded():
+		return -1
+	case a.Upper() < b.Upper():
+		return -1
+	case a.Upper() > b.Upper():
+		return 1
+	case a.UpperIncluded() == b.UpperIncluded():
+		return 0
+	case a.UpperIncluded():
+		return 1
+	}
+	return -1
+}
+
+// Move returns an interval that adds number x_interval_string to begin and end of receiver interval. For integer
+// types the values moved beyond the range of T are left out, and nil is returned if none is left.
 func (i *Interval[T]) Move(x T) IInterval[T] {
+	i = i.orEmpty()
 	if i.IsEmpty() {
 		return nil
 	}
-	return NewInterval[T](
-		i.lower+x,
-		i.upper+x,
-		i.lowerIncluded,
-		i.lowerUnbounded,
-		i.upperIncluded,
-		i.upperUnbounded,
-	)
+	lower, lowerOverflow := add(i.lower.Value, x)
+	upper, upperOverflow := add(i.upper.Value, x)
+	return i.shifted(lower, lowerOverflow, upper, upperOverflow)
+}
+
+// Expand returns receiver interval widened by lowerPad below and upperPad above, or narrowed for negative pads.
+// Unbounded sides stay unbounded, and for integer types a side is widened to at most the extreme of T. If the
+// result has no values, nil is returned.
+func (i *Interval[T]) Expand(lowerPad, upperPad T) IInterval[T] {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return nil
+	}
+	lower, lowerOverflow := sub(i.lower.Value, lowerPad)
+	upper, upperOverflow := add(i.upper.Value, upperPad)
+	return i.shifted(lower, lowerOverflow, upper, upperOverflow)
 }
 
 // Subtract returns two intervals, one on the before of x_interval_string and one on the
@@ -354,6 +717,10 @@ func (i *Interval[T]) Move(x T) IInterval[T] {
 // interval. The returned intervals are always within the range of the
 // receiver interval.
 func (i *Interval[T]) Subtract(x IInterval[T]) (IInterval[T], IInterval[T]) {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return nil, nil
+	}
 	in := i.Intersect(x)
 	if in == nil || in.IsEmpty() {
 		if i.LtBeginOf(x) {
@@ -365,18 +732,18 @@ func (i *Interval[T]) Subtract(x IInterval[T]) (IInterval[T], IInterval[T]) {
 	if x.LowerUnbounded() {
 		r1 = nil
 	} else {
-		lower := i.lower
-		if i.lower > in.Lower() {
+		lower := i.lower.Value
+		if i.lower.Value > in.Lower() {
 			lower = in.Lower()
 		}
-		r1 = maybeEmpty(NewInterval[T](lower, in.Lower(), i.lowerIncluded, i.lowerUnbounded, !in.LowerIncluded(), false))
+		r1 = maybeEmpty(NewInterval[T](lower, in.Lower(), i.lower.Kind == ClosedBound, i.lower.Kind == Unbounded, !in.LowerIncluded(), false))
 	}
 	if x.UpperUnbounded() {
 		r2 = nil
 	} else {
-		r2 = maybeEmpty(NewInterval[T](in.Upper(), i.upper, !in.UpperIncluded(), false, i.upperIncluded, i.upperUnbounded))
+		r2 = maybeEmpty(NewInterval[T](in.Upper(), i.upper.Value, !in.UpperIncluded(), false, i.upper.Kind == ClosedBound, i.upper.Kind == Unbounded))
 		if r2 != nil {
-			if r2.Lower() > r2.Upper() && i.upperUnbounded {
+			if r2.Lower() > r2.Upper() && i.upper.Kind == Unbounded {
 				r2.SetUpper(r2.Lower())
 			}
 		}
@@ -384,6 +751,25 @@ func (i *Interval[T]) Subtract(x IInterval[T]) (IInterval[T], IInterval[T]) {
 	return r1, r2
 }
 
+// SubtractAll returns the parts of receiver interval which are not in x, ascending, as Subtract does but as a slice
+// of 0, 1 or 2 intervals without nil, to be passed on to other operations on slices and sets.
+func (i *Interval[T]) SubtractAll(x IInterval[T]) []IInterval[T] {
+	if i.IsEmpty() {
+		return nil
+	}
+	if x == nil || x.IsEmpty() {
+		return []IInterval[T]{copyOf[T](i)}
+	}
+	var parts []IInterval[T]
+	before, after := i.Subtract(x)
+	for _, part := range []IInterval[T]{before, after} {
+		if part != nil && !part.IsEmpty() {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
 // Adjoin returns the union of two intervals, if the intervals are exactly
 // adjacent, or the zero interval if they are not.
 // Monica says: The Adjoin keyword in Swift is used to combine two ranges, specifically closed ranges, into a single range. It operates on ranges of any type that conforms to the Comparable protocol, allowing for flexibility in combining different types of ranges.
@@ -394,49 +780,75 @@ func (i *Interval[T]) Subtract(x IInterval[T]) (IInterval[T], IInterval[T]) {
 // However, if the input ranges are not adjacent, the Adjoin operation results in an empty range,
 // represented by an empty closed range with the same lower and upper bounds.
 func (i *Interval[T]) Adjoin(x IInterval[T]) IInterval[T] {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() || i.IsEmpty() {
 		return nil
 	}
-	if i.lowerUnbounded || i.upperUnbounded || x.UpperUnbounded() || x.LowerUnbounded() {
+	if i.lower.Kind == Unbounded || i.upper.Kind == Unbounded || x.UpperUnbounded() || x.LowerUnbounded() {
 		return nil
 	}
-	if i.lower == x.Upper() && (i.lowerIncluded || x.UpperIncluded()) {
-		x.SetUpper(i.upper)
-		x.SetUpperIncluded(i.upperIncluded)
+	if i.lower.Value == x.Upper() && (i.lower.Kind == ClosedBound || x.UpperIncluded()) {
+		x.SetUpper(i.upper.Value)
+		x.SetUpperIncluded(i.upper.Kind == ClosedBound)
 		return x
 	}
-	if i.upper == x.Lower() && (i.upperIncluded || x.LowerIncluded()) {
-		x.SetLower(i.lower)
-		x.SetLowerIncluded(i.lowerIncluded)
+	if i.upper.Value == x.Lower() && (i.upper.Kind == ClosedBound || x.LowerIncluded()) {
+		x.SetLower(i.lower.Value)
+		x.SetLowerIncluded(i.lower.Kind == ClosedBound)
 		return x
 	}
 	return nil
 }
 
+// Union returns the set of the values in receiver interval or in x, which is one interval if they overlap or
+// adjoin and two intervals otherwise.
+func (i *Interval[T]) Union(x IInterval[T]) *IntervalSet[T] {
+	s := new(IntervalSet[T])
+	s.add(i)
+	s.add(x)
+	return s
+}
+
+// Gap returns the interval of the values strictly between receiver interval and x_interval_string interval, or nil
+// if they overlap, adjoin or one of them is empty.
+func (i *Interval[T]) Gap(x IInterval[T]) IInterval[T] {
+	if x == nil || x.IsEmpty() || i.IsEmpty() {
+		return nil
+	}
+	var before, after IInterval[T] = i, x
+	if x.LtBeginOf(i) {
+		before, after = x, i
+	} else if !i.LtBeginOf(x) {
+		return nil
+	}
+	return maybeEmpty(NewInterval[T](before.Upper(), after.Lower(), !before.UpperIncluded(), false, !after.LowerIncluded(), false))
+}
+
 // Encompass returns an interval that covers the exact extents of two intervals.
 func (i *Interval[T]) Encompass(x IInterval[T]) IInterval[T] {
+	i = i.orEmpty()
 	if x == nil || x.IsEmpty() {
 		return i
 	}
 	if i.IsEmpty() {
 		return x
 	}
-	if i.lower < x.Lower() {
-		x.SetLower(i.lower)
-		x.SetLowerIncluded(i.lowerIncluded)
-	} else if i.lower == x.Lower() && i.lowerIncluded {
+	if i.lower.Value < x.Lower() {
+		x.SetLower(i.lower.Value)
+		x.SetLowerIncluded(i.lower.Kind == ClosedBound)
+	} else if i.lower.Value == x.Lower() && i.lower.Kind == ClosedBound {
 		x.SetLowerIncluded(true)
 	}
-	if i.upper > x.Upper() {
-		x.SetUpper(i.upper)
-		x.SetUpperIncluded(i.upperIncluded)
-	} else if i.upper == x.Upper() && i.upperIncluded {
+	if i.upper.Value > x.Upper() {
+		x.SetUpper(i.upper.Value)
+		x.SetUpperIncluded(i.upper.Kind == ClosedBound)
+	} else if i.upper.Value == x.Upper() && i.upper.Kind == ClosedBound {
 		x.SetUpperIncluded(true)
 	}
-	if i.lowerUnbounded {
+	if i.lower.Kind == Unbounded {
 		x.SetLowerUnbounded(true)
 	}
-	if i.upperUnbounded {
+	if i.upper.Kind == Unbounded {
 		x.SetUpperUnbounded(true)
 	}
 	return x
@@ -0,0 +1,158 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"sort"
+)
+
+// IntervalMap maps the values of disjoint intervals to a value of type V.
+type IntervalMap[T constraints.Integer | constraints.Float, V any] struct {
+	// entries holds the mapped intervals ascending, without overlap.
+	entries []MapEntry[T, V]
+	// journal records the changes of the coverage of the map if it is not nil.
+	journal *Journal[T]
+}
+
+// MapEntry is an interval of an IntervalMap with its value.
+type MapEntry[T constraints.Integer | constraints.Float, V any] struct {
+	Interval IInterval[T]
+	Value    V
+}
+
+func NewIntervalMap[T constraints.Integer | constraints.Float, V any]() *IntervalMap[T, V] {
+	return new(IntervalMap[T, V])
+}
+
+// SetJournal makes the map record how every Set, Apply and Delete changes which values are mapped in j, or stops
+// recording if j is nil.
+func (m *IntervalMap[T, V]) SetJournal(j *Journal[T]) {
+	m.journal = j
+}
+
+// Journal returns the journal the map records its changes in, or nil.
+func (m *IntervalMap[T, V]) Journal() *Journal[T] {
+	return m.journal
+}
+
+// Set maps the values of x to value, replacing what they were mapped to before.
+func (m *IntervalMap[T, V]) Set(x IInterval[T], value V) {
+	if x == nil || x.IsEmpty() {
+		return
+	}
+	if m.journal != nil {
+		m.journal.record(JournalAdd, x, Change[T]{Added: m.coverage().uncovered(x)})
+	}
+	m.delete(x)
+	m.entries = append(m.entries, MapEntry[T, V]{copyOf(x), value})
+	m.sort()
+}
+
+// Apply maps the values of x to f of what they were mapped to, with ok false for values which were not mapped. f is
+// called once for every entry which overlaps x and once for every part of x which was not mapped.
+func (m *IntervalMap[T, V]) Apply(x IInterval[T], f func(value V, ok bool) V) {
+	if x == nil || x.IsEmpty() {
+		return
+	}
+	if m.journal != nil {
+		m.journal.record(JournalAdd, x, Change[T]{Added: m.coverage().uncovered(x)})
+	}
+	var entries []MapEntry[T, V]
+	mapped := new(IntervalSet[T])
+	for _, e := range m.entries {
+		in := e.Interval.Intersect(x)
+		if in == nil {
+			entries = append(entries, e)
+			continue
+		}
+		before, after := e.Interval.Subtract(x)
+		if before != nil {
+			entries = append(entries, MapEntry[T, V]{before, e.Value})
+		}
+		if after != nil {
+			entries = append(entries, MapEntry[T, V]{after, e.Value})
+		}
+		entries = append(entries, MapEntry[T, V]{in, f(e.Value, true)})
+		mapped.add(in)
+	}
+	var zero V
+	for _, gap := range mapped.uncovered(x) {
+		entries = append(entries, MapEntry[T, V]{gap, f(zero, false)})
+	}
+	m.entries = entries
+	m.sort()
+}
+
+// Delete removes the values of x from the map.
+func (m *IntervalMap[T, V]) Delete(x IInterval[T]) {
+	if x == nil || x.IsEmpty() {
+		return
+	}
+	if m.journal != nil {
+		var removed []IInterval[T]
+		for _, e := range m.entries {
+			if in := e.Interval.Intersect(x); in != nil {
+				removed = append(removed, in)
+			}
+		}
+		m.journal.record(JournalRemove, x, Change[T]{Removed: removed})
+	}
+	m.delete(x)
+}
+
+// delete removes the values of x from the map, without recording it in the journal.
+func (m *IntervalMap[T, V]) delete(x IInterval[T]) {
+	var entries []MapEntry[T, V]
+	for _, e := range m.entries {
+		if !e.Interval.Overlaps(x) {
+			entries = append(entries, e)
+			continue
+		}
+		before, after := e.Interval.Subtract(x)
+		if before != nil {
+			entries = append(entries, MapEntry[T, V]{before, e.Value})
+		}
+		if after != nil {
+			entries = append(entries, MapEntry[T, V]{after, e.Value})
+		}
+	}
+	m.entries = entries
+}
+
+// Get returns what key is mapped to, or false if it is not mapped.
+func (m *IntervalMap[T, V]) Get(key T) (V, bool) {
+	for _, e := range m.entries {
+		if e.Interval.Has(key) {
+			return e.Value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Entries returns the mapped intervals with their values, ascending.
+func (m *IntervalMap[T, V]) Entries() []MapEntry[T, V] {
+	return append([]MapEntry[T, V](nil), m.entries...)
+}
+
+// Clone returns a map with the same entries, which can be changed without changing receiver map.
+func (m *IntervalMap[T, V]) Clone() *IntervalMap[T, V] {
+	c := new(IntervalMap[T, V])
+	c.entries = m.Entries()
+	return c
+}
+
+// sort puts the entries in ascending order.
+func (m *IntervalMap[T, V]) sort() {
+	sort.SliceStable(m.entries, func(p, q int) bool {
+		return compareLower(m.entries[p].Interval, m.entries[q].Interval) < 0
+	})
+}
+
+// coverage returns the set of the mapped values.
+func (m *IntervalMap[T, V]) coverage() *IntervalSet[T] {
+	s := new(IntervalSet[T])
+	for _, e := range m.entries {
+		s.add(e.Interval)
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIntervalMap(t *testing.T) {
+	m := NewIntervalMap[int, string]()
+	m.Set(NewInterval(0, 10, true, false, false, false), "a")
+	m.Set(NewInterval(5, 15, true, false, false, false), "b")
+	m.Apply(NewInterval(8, 20, true, false, false, false), func(v string, ok bool) string {
+		if !ok {
+			return "new"
+		}
+		return v + "+"
+	})
+	m.Delete(NewInterval(2, 3, true, false, true, false))
+	var got string
+	for _, e := range m.Entries() {
+		got += fmt.Sprintf("%s=%s ", e.Interval, e.Value)
+	}
+	want := "[0,2)=a (3,5)=a [5,8)=b [8,15)=b+ [15,20)=new "
+	if got != want {
+		t.Errorf("want entries %s but get %s", want, got)
+	}
+	for _, tc := range []struct {
+		key   int
+		value string
+		ok    bool
+	}{
+		{0, "a", true}, {2, "", false}, {4, "a", true}, {5, "b", true}, {14, "b+", true}, {19, "new", true}, {20, "", false},
+	} {
+		if v, ok := m.Get(tc.key); v != tc.value || ok != tc.ok {
+			t.Errorf("want Get(%d) = %s, %v but get %s, %v", tc.key, tc.value, tc.ok, v, ok)
+		}
+	}
+}
+
+func TestTemporalMap(t *testing.T) {
+	m := NewTemporalMap[int, bool]()
+	m.Set(NewInterval(0, 100, true, false, false, false), NewInterval[int64](10, 0, true, false, false, true), true)
+	m.Set(NewInterval(50, 60, true, false, false, false), NewInterval[int64](20, 30, true, false, false, false), false)
+	for _, tc := range []struct {
+		key   int
+		t     int64
+		value bool
+		ok    bool
+	}{
+		{10, 5, false, false}, {10, 10, true, true}, {55, 15, true, true}, {55, 25, false, true}, {55, 30, true, true},
+		{45, 25, true, true}, {100, 25, false, false},
+	} {
+		if v, ok := m.Get(tc.key, tc.t); v != tc.value || ok != tc.ok {
+			t.Errorf("want Get(%d, %d) = %v, %v but get %v, %v", tc.key, tc.t, tc.value, tc.ok, v, ok)
+		}
+	}
+	if h := m.History(55); len(h) != 3 {
+		t.Errorf("want three validity ranges for key 55 but get %v", h)
+	}
+	if h := m.History(45); len(h) != 1 {
+		t.Errorf("want one validity range for key 45 but get %v", h)
+	}
+}
@@ -0,0 +1,471 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"iter"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ErrHoleOutsideBase is returned by NewIntervalWithExclusions for a hole which is not within the base interval.
+var ErrHoleOutsideBase = errors.New("interval: hole is not within base interval")
+
+// ErrNoSpace is returned by IntervalSet.PopMin when no interval of the set is long enough.
+var ErrNoSpace = errors.New("interval: no interval of the set is long enough")
+
+// IntervalSet is a set of values given as intervals. The intervals are kept ascending, without overlap and with
+// overlapping or adjoining intervals merged, so every set has exactly one representation. For integer types intervals
+// without an integer between them adjoin, like [0, 4] and [5, 9], and only how a bound is written may differ, like
+// [0, 5) for [0, 4].
+type IntervalSet[T constraints.Integer | constraints.Float] struct {
+	// intervals is replaced by a new slice on every change, and neither it nor its intervals are changed in place
+	// once the set is built, so snapshots can share them.
+	intervals []IInterval[T]
+	// journal records the changes of the set if it is not nil.
+	journal *Journal[T]
+}
+
+// NewIntervalSet returns the set of the values of intervals, which may be given in any order and overlap. They are
+// sorted once and merged in one pass, which for many intervals is much faster than adding them one by one.
+func NewIntervalSet[T constraints.Integer | constraints.Float](intervals ...IInterval[T]) *IntervalSet[T] {
+	s := new(IntervalSet[T])
+	for _, n := range sortedByLower(intervals) {
+		s.push(intervals[n])
+	}
+	return s
+}
+
+// SetJournal makes the set record every Add and Remove in j, or stops recording if j is nil.
+func (s *IntervalSet[T]) SetJournal(j *Journal[T]) {
+	s.journal = j
+}
+
+// Journal returns the journal the set records its changes in, or nil.
+func (s *IntervalSet[T]) Journal() *Journal[T] {
+	return s.journal
+}
+
+// NewIntervalWithExclusions returns the set of the values of base which are in none of holes, like a window with
+// blackout periods. Every hole must lie within base.
+func NewIntervalWithExclusions[T constraints.Integer | constraints.Float](base IInterval[T], holes ...IInterval[T]) (*IntervalSet[T], error) {
+	s := new(IntervalSet[T])
+	if base == nil {
+		base = new(Interval[T])
+	}
+	for n, h := range holes {
+		if !base.Contains(h) {
+			return nil, fmt.Errorf("%w: hole %d %v, base %s", ErrHoleOutsideBase, n, h, base)
+		}
+	}
+	s.add(base)
+	for _, h := range holes {
+		s.Remove(h)
+	}
+	return s, nil
+}
+
+// Change is the exact effect of a change of an IntervalSet: the values which were added and which were removed.
+// Editors can keep changes to undo and redo them, instead of copies of the whole set.
+type Change[T constraints.Integer | constraints.Float] struct {
+	Added   []IInterval[T]
+	Removed []IInterval[T]
+}
+
+// Inverse returns the change which undoes c.
+func (c Change[T]) Inverse() Change[T] {
+	return Change[T]{Added: c.Removed, Removed: c.Added}
+}
+
+// IsEmpty returns true if the change added or removed nothing.
+func (c Change[T]) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0
+}
+
+// Add puts the values of x in the set and returns which of them were not in the set before.
+func (s *IntervalSet[T]) Add(x IInterval[T]) Change[T] {
+	c := Change[T]{Added: s.uncovered(x)}
+	s.journal.record(JournalAdd, x, c)
+	s.add(x)
+	return c
+}
+
+// Remove takes the values of x out of the set and returns which of them were in the set before.
+func (s *IntervalSet[T]) Remove(x IInterval[T]) Change[T] {
+	if x == nil || x.IsEmpty() {
+		return Change[T]{}
+	}
+	var intervals, removed []IInterval[T]
+	for _, c := range s.intervals {
+		in := c.Intersect(x)
+		if in == nil {
+			intervals = append(intervals, c)
+			continue
+		}
+		removed = append(removed, in)
+		before, after := c.Subtract(x)
+		if before != nil {
+			intervals = append(intervals, before)
+		}
+		if after != nil {
+			intervals = append(intervals, after)
+		}
+	}
+	c := Change[T]{Removed: removed}
+	s.journal.record(JournalRemove, x, c)
+	s.intervals = intervals
+	return c
+}
+
+// Contains returns true if every value of x is in the set. An empty x is in every set.
+func (s *IntervalSet[T]) Contains(x IInterval[T]) bool {
+	return s.ContainsInterval(x)
+}
+
+// Has returns true if value is in the set, finding the interval it may be in by binary search.
+func (s *IntervalSet[T]) Has(value T) bool {
+	if isNaN(value) {
+		return false
+	}
+	n := s.first(Point(value))
+	return n < len(s.intervals) && s.intervals[n].Has(value)
+}
+
+// ContainsInterval returns true if every value of x is in the set, finding the intervals x may be in by binary
+// search. An empty x is in every set.
+func (s *IntervalSet[T]) ContainsInterval(x IInterval[T]) bool {
+	return len(s.uncovered(x)) == 0
+}
+
+// PopMin removes the lowest n values from the first interval of the set which has room for them and returns them,
+// so the set can be used as a free list of IDs, ports or offsets. For integer types these are n consecutive integers,
+// as [100, 103] for n = 4, and an unbounded lower side begins at the lowest value of T; for floating point types the
+// interval has length n and excludes its upper bound, as [100, 104), and an unbounded lower side has no lowest value
+// to begin at. PopMin returns ErrNoSpace if no interval is long enough or n is not positive.
+func (s *IntervalSet[T]) PopMin(n T) (IInterval[T], error) {
+	if n > 0 {
+		for _, c := range s.intervals {
+			var piece IInterval[T]
+			if discrete[T]() {
+				lower, _ := extremes[T]()
+				if x := closed(c); !x.LowerUnbounded() {
+					lower = x.Lower()
+				}
+				upper, overflow := add(lower, n-1)
+				if overflow != 0 || !c.Has(upper) {
+					continue
+				}
+				piece = Closed(lower, upper)
+			} else {
+				if c.LowerUnbounded() {
+					continue
+				}
+				piece = NewInterval(c.Lower(), c.Lower()+n, c.LowerIncluded(), false, false, false)
+				if !c.Contains(piece) {
+					continue
+				}
+			}
+			s.Remove(piece)
+			return piece, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: length %v", ErrNoSpace, n)
+}
+
+// Apply makes change c to the set: the removed values of c are removed, then the added values added. Applying the
+// Inverse of the change returned by Add or Remove undoes it, applying that change again redoes it.
+func (s *IntervalSet[T]) Apply(c Change[T]) {
+	for _, x := range c.Removed {
+		s.Remove(x)
+	}
+	for _, x := range c.Added {
+		s.Add(x)
+	}
+}
+
+// Union returns a new set with the values of the set and of other, merging their intervals in one pass. A nil set is
+// empty.
+func (s *IntervalSet[T]) Union(other *IntervalSet[T]) *IntervalSet[T] {
+	a, b := s.members(), other.members()
+	r := new(IntervalSet[T])
+	for len(a) > 0 || len(b) > 0 {
+		if len(b) == 0 || len(a) > 0 && compareLower(a[0], b[0]) <= 0 {
+			r.push(a[0])
+			a = a[1:]
+		} else {
+			r.push(b[0])
+			b = b[1:]
+		}
+	}
+	return r
+}
+
+// Intersect returns a new set with the values which are both in the set and in other, as the times two calendars
+// are both free, sweeping once over the intervals of both. A nil set is empty.
+func (s *IntervalSet[T]) Intersect(other *IntervalSet[T]) *IntervalSet[T] {
+	a, b := s.members(), other.members()
+	r := new(IntervalSet[T])
+	for len(a) > 0 && len(b) > 0 {
+		r.push(a[0].Intersect(b[0]))
+		if compareUpper(a[0], b[0]) < 0 {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return r
+}
+
+// Subtract returns a new set with the values of the set which are not in other, as scheduled days minus holidays,
+// sweeping once over the intervals of both. A nil set is empty.
+func (s *IntervalSet[T]) Subtract(other *IntervalSet[T]) *IntervalSet[T] {
+	b := other.members()
+	r := new(IntervalSet[T])
+	for _, c := range s.members() {
+		for len(b) > 0 && b[0].LtBeginOf(c) {
+			b = b[1:]
+		}
+		rest := c
+		for k := 0; k < len(b) && rest != nil && !rest.LtBeginOf(b[k]); k++ {
+			var before IInterval[T]
+			before, rest = rest.Subtract(b[k])
+			r.push(before)
+		}
+		r.push(rest)
+	}
+	return r
+}
+
+// SymmetricDifference returns a new set with the values which are in exactly one of the set and other, as what
+// changed between two coverage maps. A nil set is empty.
+func (s *IntervalSet[T]) SymmetricDifference(other *IntervalSet[T]) *IntervalSet[T] {
+	return s.Subtract(other).Union(other.Subtract(s))
+}
+
+// Diff returns the values which are only in the set and those which are only in other, as what was removed and what
+// was added between two snapshots. Together they are the SymmetricDifference. A nil set is empty.
+func (s *IntervalSet[T]) Diff(other *IntervalSet[T]) (onlyInS, onlyInOther *IntervalSet[T]) {
+	return s.Subtract(other), other.Subtract(s)
+}
+
+// Equal returns true if the set and other have the same values, also when integer intervals are written
+// differently, as {[1,3], [4,6]} and {[1,6]}. A nil set is empty.
+func (s *IntervalSet[T]) Equal(other *IntervalSet[T]) bool {
+	return s.IsSubsetOf(other) && other.IsSubsetOf(s)
+}
+
+// IsSubsetOf returns true if every value of the set is in other.
+func (s *IntervalSet[T]) IsSubsetOf(other *IntervalSet[T]) bool {
+	return len(s.Subtract(other).intervals) == 0
+}
+
+// IsSupersetOf returns true if every value of other is in the set.
+func (s *IntervalSet[T]) IsSupersetOf(other *IntervalSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Snapshot returns a copy of the set which later changes of the set do not affect, and whose changes do not affect the
+// set, without copying its intervals: the set and the snapshot share them until either changes. A reader can keep a
+// snapshot as a consistent view while a writer goes on changing the set; only taking the snapshot must be guarded
+// against concurrent changes. The snapshot has no journal.
+func (s *IntervalSet[T]) Snapshot() *IntervalSet[T] {
+	intervals := s.members()
+	return &IntervalSet[T]{intervals: intervals[:len(intervals):len(intervals)]}
+}
+
+// ClampTo returns a new set with the values of the set within window, as the visible part of a timeline. It finds the
+// first interval in window by binary search and stops after the last, so only the intervals in window are visited.
+func (s *IntervalSet[T]) ClampTo(window IInterval[T]) *IntervalSet[T] {
+	r := new(IntervalSet[T])
+	if window == nil || window.IsEmpty() {
+		return r
+	}
+	for _, c := range s.intervals[s.first(window):] {
+		if window.LtBeginOf(c) {
+			break
+		}
+		r.push(c.Intersect(window))
+	}
+	return r
+}
+
+// Intervals returns the intervals of the set, ascending.
+func (s *IntervalSet[T]) Intervals() []IInterval[T] {
+	return append([]IInterval[T](nil), s.intervals...)
+}
+
+// All returns the intervals of the set, ascending, so that for x := range s.All() visits them without copying them
+// as Intervals does. The set must not be changed during the loop.
+func (s *IntervalSet[T]) All() iter.Seq[IInterval[T]] {
+	return func(yield func(IInterval[T]) bool) {
+		for _, x := range s.members() {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of intervals of the set.
+func (s *IntervalSet[T]) Len() int {
+	return len(s.members())
+}
+
+// At returns interval n of the set, counting from 0 in ascending order. It panics if n is not below Len.
+func (s *IntervalSet[T]) At(n int) IInterval[T] {
+	return s.intervals[n]
+}
+
+// IsEmpty returns true if the set has no value.
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Count returns the exact number of integers in the set, which may be more than an int64 can hold, as for the full
+// range of uint64. For integer types an unbounded side runs to the lowest or highest value of T; for floating point
+// types a set with an unbounded side has infinitely many integers, for which ErrUnboundedSet is returned.
+func (s *IntervalSet[T]) Count() (*big.Int, error) {
+	count, one := new(big.Int), big.NewInt(1)
+	var lower, upper big.Int
+	for x := range s.All() {
+		if discrete[T]() {
+			lowest, highest := extremes[T]()
+			c := closed(x)
+			if !c.LowerUnbounded() {
+				lowest = c.Lower()
+			}
+			if !c.UpperUnbounded() {
+				highest = c.Upper()
+			}
+			bigInteger(&lower, lowest)
+			bigInteger(&upper, highest)
+		} else {
+			if x.LowerUnbounded() || x.UpperUnbounded() {
+				return nil, fmt.Errorf("%w: %s", ErrUnboundedSet, x)
+			}
+			lo, hi := math.Ceil(float64(x.Lower())), math.Floor(float64(x.Upper()))
+			if lo == float64(x.Lower()) && !x.LowerIncluded() {
+				lo++
+			}
+			if hi == float64(x.Upper()) && !x.UpperIncluded() {
+				hi--
+			}
+			if lo > hi {
+				continue
+			}
+			big.NewFloat(lo).Int(&lower)
+			big.NewFloat(hi).Int(&upper)
+		}
+		count.Add(count, upper.Sub(&upper, &lower).Add(&upper, one))
+	}
+	return count, nil
+}
+
+// Extent returns the smallest interval which covers the whole set, from the begin of its first interval to the end
+// of its last, or nil if the set is empty.
+func (s *IntervalSet[T]) Extent() IInterval[T] {
+	if len(s.intervals) == 0 {
+		return nil
+	}
+	return span(s.intervals[0], s.intervals[len(s.intervals)-1])
+}
+
+func (s *IntervalSet[T]) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, x := range s.intervals {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(x.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// add puts a copy of x in the set, merging it with the intervals it overlaps or adjoins.
+func (s *IntervalSet[T]) add(x IInterval[T]) {
+	if x == nil || x.IsEmpty() {
+		return
+	}
+	var merged IInterval[T] = copyOf(x)
+	intervals := make([]IInterval[T], 0, len(s.intervals)+1)
+	for _, c := range s.intervals {
+		switch {
+		case merged == nil || compareLower(c, merged) < 0 && !mergeable(c, merged):
+			intervals = append(intervals, c)
+		case mergeable(c, merged):
+			merged = span(c, merged)
+		default:
+			intervals = append(intervals, merged, c)
+			merged = nil
+		}
+	}
+	if merged != nil {
+		intervals = append(intervals, merged)
+	}
+	s.intervals = intervals
+}
+
+// push puts a copy of x, which begins no earlier than the intervals of the set, at its end, merging it with the last
+// interval if they overlap or adjoin.
+func (s *IntervalSet[T]) push(x IInterval[T]) {
+	if x == nil || x.IsEmpty() {
+		return
+	}
+	if n := len(s.intervals); n > 0 && mergeable(s.intervals[n-1], x) {
+		s.intervals[n-1] = span(s.intervals[n-1], x)
+		return
+	}
+	s.intervals = append(s.intervals, copyOf(x))
+}
+
+// members returns the intervals of the set, ascending, or nil for a nil set.
+func (s *IntervalSet[T]) members() []IInterval[T] {
+	if s == nil {
+		return nil
+	}
+	return s.intervals
+}
+
+// uncovered returns the parts of x which have no value in the set, ascending.
+func (s *IntervalSet[T]) uncovered(x IInterval[T]) []IInterval[T] {
+	if x == nil || x.IsEmpty() {
+		return nil
+	}
+	var parts []IInterval[T]
+	var rest IInterval[T] = copyOf(x)
+	for _, c := range s.intervals[s.first(x):] {
+		if rest == nil || rest.LtBeginOf(c) {
+			break
+		}
+		before, after := rest.Subtract(c)
+		if before != nil {
+			parts = append(parts, before)
+		}
+		rest = after
+	}
+	if rest != nil {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+// bigInteger sets b to v, which is of an integer type.
+func bigInteger[T constraints.Integer | constraints.Float](b *big.Int, v T) {
+	if v < 0 {
+		b.SetInt64(int64(v))
+	} else {
+		b.SetUint64(uint64(v))
+	}
+}
+
+// first returns the index of the first interval of the set which does not end before x begins, by binary search.
+func (s *IntervalSet[T]) first(x IInterval[T]) int {
+	return sort.Search(len(s.intervals), func(n int) bool {
+		return !s.intervals[n].LtBeginOf(x)
+	})
+}
@@ -0,0 +1,438 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestIntervalSetUndoRedo(t *testing.T) {
+	s := new(IntervalSet[float64])
+	s.Add(NewInterval(0.0, 10, true, false, false, false))
+	var history []Change[float64]
+	history = append(history, s.Add(NewInterval(5.0, 15, true, false, true, false)))
+	history = append(history, s.Remove(NewInterval(2.0, 12, false, false, false, false)))
+	if s.String() != "{[0,2], [12,15]}" {
+		t.Fatalf("want {[0,2], [12,15]} but get %s", s)
+	}
+	if len(history[0].Added) != 1 || !history[0].Added[0].Equal(NewInterval(10.0, 15, true, false, true, false)) {
+		t.Errorf("want Add to report [10,15] as added but get %v", history[0].Added)
+	}
+	states := []string{"{[0,10)}", "{[0,15]}"}
+	for n := len(history) - 1; n >= 0; n-- {
+		s.Apply(history[n].Inverse())
+		if s.String() != states[n] {
+			t.Errorf("want undo to restore %s but get %s", states[n], s)
+		}
+	}
+	for _, c := range history {
+		s.Apply(c)
+	}
+	if s.String() != "{[0,2], [12,15]}" {
+		t.Errorf("want redo to restore {[0,2], [12,15]} but get %s", s)
+	}
+	if c := s.Add(NewInterval(0.0, 1, true, false, true, false)); !c.IsEmpty() {
+		t.Errorf("want adding covered values to change nothing but get %v", c)
+	}
+}
+
+func TestNewIntervalWithExclusions(t *testing.T) {
+	s, er := NewIntervalWithExclusions[int](
+		NewInterval(0, 24, true, false, false, false),
+		NewInterval(12, 13, true, false, false, false),
+		NewInterval(0, 8, true, false, false, false),
+		NewInterval(18, 20, false, false, true, false),
+	)
+	if er != nil || s.String() != "{[8,12), [13,18], (20,24)}" {
+		t.Errorf("want {[8,12), [13,18], (20,24)} but get %v, %v", s, er)
+	}
+	_, er = NewIntervalWithExclusions[int](
+		NewInterval(0, 24, true, false, false, false),
+		NewInterval(20, 24, true, false, true, false),
+	)
+	if !errors.Is(er, ErrHoleOutsideBase) {
+		t.Errorf("want %v but get %v", ErrHoleOutsideBase, er)
+	}
+}
+
+func TestIntervalSetAddRemoveContains(t *testing.T) {
+	s := new(IntervalSet[int])
+	s.Add(ClosedOpen(10, 20))
+	s.Add(ClosedOpen(0, 5))
+	s.Add(Closed(4, 10))
+	s.Add(Closed(30, 40))
+	if s.String() != "{[0,20), [30,40]}" {
+		t.Fatalf("want {[0,20), [30,40]} but get %s", s)
+	}
+	s.Remove(Open(32, 35))
+	if s.String() != "{[0,20), [30,32], [35,40]}" {
+		t.Errorf("want {[0,20), [30,32], [35,40]} but get %s", s)
+	}
+	for _, tc := range []struct {
+		x    IInterval[int]
+		want bool
+	}{
+		{Closed(2, 19), true},
+		{Closed(2, 20), false},
+		{Closed(30, 32), true},
+		{Closed(30, 33), false},
+		{Open(32, 35), false},
+		{Open(32, 33), true},
+		{nil, true},
+	} {
+		if got := s.Contains(tc.x); got != tc.want {
+			t.Errorf("want %s.Contains(%v) = %v but get %v", s, tc.x, tc.want, got)
+		}
+	}
+}
+
+func TestIntervalSetMergeIntegers(t *testing.T) {
+	s := new(IntervalSet[int])
+	s.Add(Closed(5, 9))
+	s.Add(Closed(20, 30))
+	s.Add(Closed(0, 4))
+	s.Add(OpenClosed(9, 19))
+	if s.String() != "{[0,30]}" {
+		t.Errorf("want intervals without an integer between them merged into {[0,30]} but get %s", s)
+	}
+	if u := Closed(0, 4).Union(ClosedOpen(5, 10)); u.String() != "{[0,10)}" {
+		t.Errorf("want [0,4].Union([5,10)) = {[0,10)} but get %s", u)
+	}
+	if u := Closed(0, 4).Union(Closed(6, 9)); u.Len() != 2 {
+		t.Errorf("want [0,4].Union([6,9]) to keep two intervals but get %s", u)
+	}
+}
+
+func TestIntervalSetUnion(t *testing.T) {
+	a, b := new(IntervalSet[float64]), new(IntervalSet[float64])
+	for _, x := range []IInterval[float64]{Closed(0.0, 2), Closed(5.0, 7), Greater(20.0)} {
+		a.Add(x)
+	}
+	for _, x := range []IInterval[float64]{Open(1.0, 3), OpenClosed(7.0, 8), Closed(10.0, 11), Point(15.0)} {
+		b.Add(x)
+	}
+	want := "{[0,3), [5,8], [10,11], [15,15], (20,+∞)}"
+	if got := a.Union(b); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := b.Union(a); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := a.Union(nil); got.String() != a.String() {
+		t.Errorf("want %s with a nil set but get %s", a, got)
+	}
+	if a.String() != "{[0,2], [5,7], (20,+∞)}" {
+		t.Errorf("want Union to leave the set unchanged but get %s", a)
+	}
+}
+
+func TestIntervalSetIntersect(t *testing.T) {
+	a, b := new(IntervalSet[int]), new(IntervalSet[int])
+	for _, x := range []IInterval[int]{ClosedOpen(9, 12), ClosedOpen(13, 17)} {
+		a.Add(x)
+	}
+	for _, x := range []IInterval[int]{ClosedOpen(8, 10), ClosedOpen(11, 14), ClosedOpen(16, 18)} {
+		b.Add(x)
+	}
+	want := "{[9,10), [11,12), [13,14), [16,17)}"
+	if got := a.Intersect(b); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := b.Intersect(a); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := a.Intersect(nil); !got.IsEmpty() {
+		t.Errorf("want an empty set with a nil set but get %s", got)
+	}
+	all := new(IntervalSet[int])
+	all.Add(All[int]())
+	if got := all.Intersect(a); got.String() != a.String() {
+		t.Errorf("want %s but get %s", a, got)
+	}
+}
+
+func TestIntervalSetSubtract(t *testing.T) {
+	scheduled, holidays := new(IntervalSet[int]), new(IntervalSet[int])
+	for _, x := range []IInterval[int]{Closed(1, 10), Closed(20, 31)} {
+		scheduled.Add(x)
+	}
+	for _, x := range []IInterval[int]{Point(0), Closed(3, 4), Point(7), Closed(9, 21), Point(25), AtLeast(31)} {
+		holidays.Add(x)
+	}
+	want := "{[1,3), (4,7), (7,9), (21,25), (25,31)}"
+	if got := scheduled.Subtract(holidays); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := scheduled.Subtract(nil); got.String() != scheduled.String() {
+		t.Errorf("want %s without holidays but get %s", scheduled, got)
+	}
+	if got := holidays.Subtract(holidays); !got.IsEmpty() {
+		t.Errorf("want an empty set but get %s", got)
+	}
+}
+
+func TestIntervalSetSymmetricDifference(t *testing.T) {
+	before, after := new(IntervalSet[float64]), new(IntervalSet[float64])
+	for _, x := range []IInterval[float64]{ClosedOpen(0.0, 10), ClosedOpen(20.0, 30)} {
+		before.Add(x)
+	}
+	for _, x := range []IInterval[float64]{ClosedOpen(5.0, 10), ClosedOpen(20.0, 35)} {
+		after.Add(x)
+	}
+	want := "{[0,5), [30,35)}"
+	if got := before.SymmetricDifference(after); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := after.SymmetricDifference(before); got.String() != want {
+		t.Errorf("want %s but get %s", want, got)
+	}
+	if got := before.SymmetricDifference(before); !got.IsEmpty() {
+		t.Errorf("want an empty set for equal sets but get %s", got)
+	}
+	if got := before.SymmetricDifference(nil); got.String() != before.String() {
+		t.Errorf("want %s with a nil set but get %s", before, got)
+	}
+}
+
+func TestIntervalSetHasContainsInterval(t *testing.T) {
+	s := new(IntervalSet[float64])
+	for _, x := range []IInterval[float64]{Less(-10.0), ClosedOpen(0.0, 1), OpenClosed(1.0, 2), Closed(5.0, 6)} {
+		s.Add(x)
+	}
+	for _, tc := range []struct {
+		value float64
+		want  bool
+	}{
+		{-100, true}, {-10, false}, {0, true}, {0.5, true}, {1, false}, {1.5, true}, {2, true}, {3, false},
+		{5, true}, {6, true}, {7, false}, {math.NaN(), false},
+	} {
+		if got := s.Has(tc.value); got != tc.want {
+			t.Errorf("want %s.Has(%v) = %v but get %v", s, tc.value, tc.want, got)
+		}
+	}
+	for _, tc := range []struct {
+		x    IInterval[float64]
+		want bool
+	}{
+		{Closed(0.0, 0.9), true}, {Closed(0.0, 1), false}, {Open(1.0, 2), true}, {Closed(5.5, 6), true},
+		{Closed(2.0, 5), false}, {AtMost(-11.0), true}, {All[float64](), false}, {nil, true},
+	} {
+		if got := s.ContainsInterval(tc.x); got != tc.want {
+			t.Errorf("want %s.ContainsInterval(%v) = %v but get %v", s, tc.x, tc.want, got)
+		}
+	}
+	ints := new(IntervalSet[int])
+	ints.Add(Closed(0, 2))
+	ints.Add(Closed(3, 5))
+	if !ints.ContainsInterval(Closed(1, 4)) || ints.ContainsInterval(Closed(1, 6)) {
+		t.Errorf("want %s to contain [1,4] and not [1,6]", ints)
+	}
+}
+
+func TestIntervalSetExtent(t *testing.T) {
+	s := new(IntervalSet[int])
+	if got := s.Extent(); got != nil {
+		t.Errorf("want nil for an empty set but get %s", got)
+	}
+	s.Add(OpenClosed(3, 5))
+	if got := s.Extent(); !got.Equal(OpenClosed(3, 5)) {
+		t.Errorf("want (3,5] but get %s", got)
+	}
+	s.Add(ClosedOpen(10, 12))
+	s.Add(Closed(7, 8))
+	if got := s.Extent(); !got.Equal(Open(3, 12)) {
+		t.Errorf("want (3,12) but get %s", got)
+	}
+	s.Add(AtMost(0))
+	if got := s.Extent(); !got.Equal(Less(12)) {
+		t.Errorf("want (-∞,12) but get %s", got)
+	}
+}
+
+func TestIntervalSetEqualSubset(t *testing.T) {
+	a, b, c := new(IntervalSet[int]), new(IntervalSet[int]), new(IntervalSet[int])
+	a.Add(Closed(1, 3))
+	a.Add(Closed(4, 6))
+	b.Add(Closed(1, 6))
+	c.Add(Open(1, 6))
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Errorf("want %s and %s to be equal", a, b)
+	}
+	if a.Equal(c) || !c.IsSubsetOf(a) || c.IsSupersetOf(a) || !a.IsSupersetOf(c) || a.IsSubsetOf(c) {
+		t.Errorf("want %s to be a proper subset of %s", c, a)
+	}
+	var empty *IntervalSet[int]
+	if !empty.IsSubsetOf(a) || a.IsSubsetOf(empty) || !empty.Equal(new(IntervalSet[int])) {
+		t.Errorf("want the empty set to be a subset of %s and equal to another empty set", a)
+	}
+}
+
+func TestIntervalSetAllLenAt(t *testing.T) {
+	s := new(IntervalSet[int])
+	for _, x := range []IInterval[int]{Closed(7, 8), Closed(1, 2), Closed(4, 5)} {
+		s.Add(x)
+	}
+	if s.Len() != 3 || !s.At(0).Equal(Closed(1, 2)) || !s.At(2).Equal(Closed(7, 8)) {
+		t.Errorf("want 3 intervals from [1,2] to [7,8] but get %s", s)
+	}
+	var lowers []int
+	for x := range s.All() {
+		lowers = append(lowers, x.Lower())
+		if x.Lower() == 4 {
+			break
+		}
+	}
+	if !slices.Equal(lowers, []int{1, 4}) {
+		t.Errorf("want to visit [1,2] and [4,5] but get lowers %v", lowers)
+	}
+	var none *IntervalSet[int]
+	for x := range none.All() {
+		t.Errorf("want no intervals of a nil set but get %s", x)
+	}
+	if none.Len() != 0 {
+		t.Errorf("want Len 0 of a nil set but get %d", none.Len())
+	}
+}
+
+func TestNewIntervalSet(t *testing.T) {
+	s := NewIntervalSet[int](Closed(20, 30), nil, ClosedOpen(0, 5), Open(7, 7), Closed(3, 10), Greater(25), Point(15))
+	if want := "{[0,10], [15,15], [20,+∞)}"; s.String() != want {
+		t.Errorf("want %s but get %s", want, s)
+	}
+	r := rand.New(rand.NewSource(1))
+	intervals := make([]IInterval[int], 1000)
+	added := new(IntervalSet[int])
+	for n := range intervals {
+		intervals[n] = GenerateRandom[int](r, RandomConfig[int]{Min: -1000, Max: 1000, Empty: 0.1})
+		added.Add(intervals[n])
+	}
+	if got := NewIntervalSet(intervals...); got.String() != added.String() {
+		t.Errorf("want the set made by Add %s but get %s", added, got)
+	}
+	if got := NewIntervalSet[int](); !got.IsEmpty() {
+		t.Errorf("want an empty set but get %s", got)
+	}
+}
+
+func TestIntervalSetDiff(t *testing.T) {
+	before := NewIntervalSet[int](Closed(0, 10), Closed(20, 30))
+	after := NewIntervalSet[int](Closed(5, 10), Closed(20, 40))
+	removed, added := before.Diff(after)
+	if removed.String() != "{[0,5)}" || added.String() != "{(30,40]}" {
+		t.Errorf("want {[0,5)} removed and {(30,40]} added but get %s and %s", removed, added)
+	}
+	if removed, added := before.Diff(before); !removed.IsEmpty() || !added.IsEmpty() {
+		t.Errorf("want no difference of a set with itself but get %s and %s", removed, added)
+	}
+}
+
+func TestIntervalSetSnapshot(t *testing.T) {
+	s := NewIntervalSet[int](Closed(0, 10), Closed(20, 30))
+	view := s.Snapshot()
+	s.Add(Closed(40, 50))
+	s.Remove(Closed(5, 25))
+	if view.String() != "{[0,10], [20,30]}" {
+		t.Errorf("want the snapshot unchanged by changes of the set but get %s", view)
+	}
+	if s.String() != "{[0,5), (25,30], [40,50]}" {
+		t.Errorf("want the set changed but get %s", s)
+	}
+	view.Add(Closed(12, 18))
+	if s.String() != "{[0,5), (25,30], [40,50]}" || view.String() != "{[0,10], [12,18], [20,30]}" {
+		t.Errorf("want changes of the snapshot to leave the set unchanged but get %s and %s", s, view)
+	}
+	if n := testing.AllocsPerRun(100, func() { s.Snapshot() }); n > 1 {
+		t.Errorf("want a snapshot without copying intervals but get %v allocations", n)
+	}
+}
+
+func TestIntervalSetCount(t *testing.T) {
+	for _, tc := range []struct {
+		s    *IntervalSet[int]
+		want string
+	}{
+		{NewIntervalSet[int](Closed(1, 3), Open(5, 9), Point(12)), "7"},
+		{NewIntervalSet[int](), "0"},
+		{NewIntervalSet[int](All[int]()), "18446744073709551616"},
+	} {
+		if got, er := tc.s.Count(); er != nil || got.String() != tc.want {
+			t.Errorf("want %s.Count() = %s but get %v, %v", tc.s, tc.want, got, er)
+		}
+	}
+	if got, er := NewIntervalSet[uint8](AtLeast[uint8](250)).Count(); er != nil || got.Int64() != 6 {
+		t.Errorf("want 6 integers from 250 to 255 but get %v, %v", got, er)
+	}
+	if got, er := NewIntervalSet[float64](Closed(0.5, 3), Open(4.0, 6)).Count(); er != nil || got.Int64() != 4 {
+		t.Errorf("want 1, 2, 3 and 5 but get %v, %v", got, er)
+	}
+	if _, er := NewIntervalSet[float64](AtLeast(0.0)).Count(); !errors.Is(er, ErrUnboundedSet) {
+		t.Errorf("want %v but get %v", ErrUnboundedSet, er)
+	}
+}
+
+func TestIntervalSetPopMin(t *testing.T) {
+	free := NewIntervalSet[int](Closed(100, 102), Closed(200, 299))
+	for _, tc := range []struct {
+		n    int
+		want IInterval[int]
+	}{
+		{2, Closed(100, 101)},
+		{2, Closed(200, 201)},
+		{1, Point(102)},
+		{98, Closed(202, 299)},
+	} {
+		if got, er := free.PopMin(tc.n); er != nil || !got.Equal(tc.want) {
+			t.Errorf("want PopMin(%d) = %s but get %v, %v", tc.n, tc.want, got, er)
+		}
+	}
+	if !free.IsEmpty() {
+		t.Errorf("want all values popped but get %s", free)
+	}
+	if _, er := free.PopMin(1); !errors.Is(er, ErrNoSpace) {
+		t.Errorf("want %v from an empty set but get %v", ErrNoSpace, er)
+	}
+	ids := new(IntervalSet[int])
+	ids.Add(Closed(0, 2))
+	ids.Add(Closed(3, 10))
+	if got, er := ids.PopMin(5); er != nil || !got.Equal(Closed(0, 4)) {
+		t.Errorf("want PopMin(5) = [0,4] across two added intervals but get %v, %v", got, er)
+	}
+	ids.Add(Closed(0, 4))
+	if got, er := ids.PopMin(11); er != nil || !got.Equal(Closed(0, 10)) || !ids.IsEmpty() {
+		t.Errorf("want PopMin(11) = [0,10] leaving nothing but get %v, %v and %s", got, er, ids)
+	}
+	ports := NewIntervalSet[uint16](Greater[uint16](65530))
+	if _, er := ports.PopMin(6); !errors.Is(er, ErrNoSpace) {
+		t.Errorf("want %v for more ports than there are but get %v", ErrNoSpace, er)
+	}
+	if got, er := ports.PopMin(5); er != nil || !got.Equal(Closed[uint16](65531, 65535)) {
+		t.Errorf("want [65531,65535] but get %v, %v", got, er)
+	}
+	offsets := NewIntervalSet[float64](ClosedOpen(0.0, 10), AtLeast(20.0))
+	if got, er := offsets.PopMin(12); er != nil || !got.Equal(ClosedOpen(20.0, 32)) || offsets.String() != "{[0,10), [32,+∞)}" {
+		t.Errorf("want [20,32) popped leaving {[0,10), [32,+∞)} but get %v, %v and %s", got, er, offsets)
+	}
+	if _, er := offsets.PopMin(0); !errors.Is(er, ErrNoSpace) {
+		t.Errorf("want %v for length 0 but get %v", ErrNoSpace, er)
+	}
+}
+
+func TestIntervalSetClampTo(t *testing.T) {
+	s := NewIntervalSet[float64](Less(0.0), Closed(1.0, 3), Closed(5.0, 7), Closed(9.0, 11), Greater(20.0))
+	for _, tc := range []struct {
+		window IInterval[float64]
+		want   string
+	}{
+		{ClosedOpen(2.0, 10), "{[2,3], [5,7], [9,10)}"},
+		{Closed(3.5, 4.5), "{}"},
+		{AtMost(1.0), "{(-∞,0), [1,1]}"},
+		{All[float64](), s.String()},
+		{nil, "{}"},
+	} {
+		if got := s.ClampTo(tc.window); got.String() != tc.want {
+			t.Errorf("want %s.ClampTo(%v) = %s but get %s", s, tc.window, tc.want, got)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"golang.org/x/exp/constraints"
+	"math"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -122,6 +124,25 @@ func TestIntervalSubtract(t *testing.T) {
 	testIntervalSubtract[float64](t)
 }
 
+func TestIntervalSubtractAll(t *testing.T) {
+	for _, tc := range []struct {
+		i, x IInterval[int]
+		want []IInterval[int]
+	}{
+		{Closed(0, 10), Closed(3, 5), []IInterval[int]{ClosedOpen(0, 3), OpenClosed(5, 10)}},
+		{Closed(0, 10), AtMost(5), []IInterval[int]{OpenClosed(5, 10)}},
+		{Closed(0, 10), Closed(20, 30), []IInterval[int]{Closed(0, 10)}},
+		{Closed(0, 10), nil, []IInterval[int]{Closed(0, 10)}},
+		{Closed(0, 10), All[int](), nil},
+		{Open(3, 3), Closed(0, 1), nil},
+	} {
+		got := tc.i.SubtractAll(tc.x)
+		if !slices.EqualFunc(got, tc.want, func(a, b IInterval[int]) bool { return a.Equal(b) }) {
+			t.Errorf("want %s.SubtractAll(%v) = %v but get %v", tc.i, tc.x, tc.want, got)
+		}
+	}
+}
+
 func TestIntervalAdjoin(t *testing.T) {
 	testIntervalAdjoin[int](t)
 	testIntervalAdjoin[float64](t)
@@ -164,7 +185,6 @@ func testIntervalLtBeginOf[T constraints.Integer | constraints.Float](t *testing
 			if er != nil {
 				t.Errorf(er.Error())
 				return
-				return
 			}
 			a, b := i.LtBeginOf(x), x.LtBeginOf(i)
 			if a != tc.i_Before_x {
@@ -258,7 +278,7 @@ func testIntervalIntersect[T constraints.Integer | constraints.Float](t *testing
 				return
 			}
 			if we == nil {
-				if ee == nil {
+				if e == nil {
 					return
 				}else{
 					t.Errorf("want %s.Intersect(%s) = %s, (%s) (result conform test) but is actually %s, counter: %v-a\n%s\n%s",
@@ -266,7 +286,7 @@ func testIntervalIntersect[T constraints.Integer | constraints.Float](t *testing
 					return
 				}
 			}else{
-				if ee == nil {
+				if e == nil {
 					t.Errorf("want %s.Intersect(%s) = %s, (%s) (result conform test) but is actually %s, counter: %v-a\n%s\n%s",
 						i, x, we, tc.i_intersect_x, "nil", tc.test.counter, tc.test.i_interval_string, tc.test.x_interval_string)
 					return
@@ -831,7 +851,7 @@ var testsParseInterval = []struct {
 		s:              " <|=====|",
 		lowerUnbounded: true,
 		upperUnbounded: false,
-		lowerIncluded:  true,
+		lowerIncluded:  false,
 		upperIncluded:  true,
 		begin:          0,
 		end:            5,
@@ -841,7 +861,7 @@ var testsParseInterval = []struct {
 		lowerUnbounded: false,
 		upperUnbounded: true,
 		lowerIncluded:  true,
-		upperIncluded:  true,
+		upperIncluded:  false,
 		begin:          0,
 		end:            5,
 	},
@@ -849,8 +869,8 @@ var testsParseInterval = []struct {
 		s:              " <|=====|>",
 		lowerUnbounded: true,
 		upperUnbounded: true,
-		lowerIncluded:  true,
-		upperIncluded:  true,
+		lowerIncluded:  false,
+		upperIncluded:  false,
 		begin:          0,
 		end:            5,
 	},
@@ -922,7 +942,7 @@ var testsParseInterval = []struct {
 		s:              " <|--=====|",
 		lowerUnbounded: true,
 		upperUnbounded: false,
-		lowerIncluded:  true,
+		lowerIncluded:  false,
 		upperIncluded:  true,
 		begin:          2,
 		end:            7,
@@ -932,7 +952,7 @@ var testsParseInterval = []struct {
 		lowerUnbounded: false,
 		upperUnbounded: true,
 		lowerIncluded:  true,
-		upperIncluded:  true,
+		upperIncluded:  false,
 		begin:          2,
 		end:            7,
 	},
@@ -940,8 +960,8 @@ var testsParseInterval = []struct {
 		s:              " <|--=====|>",
 		lowerUnbounded: true,
 		upperUnbounded: true,
-		lowerIncluded:  true,
-		upperIncluded:  true,
+		lowerIncluded:  false,
+		upperIncluded:  false,
 		begin:          2,
 		end:            7,
 	},
@@ -1013,7 +1033,7 @@ var testsParseInterval = []struct {
 		s:              " <|--=====--|",
 		lowerUnbounded: true,
 		upperUnbounded: false,
-		lowerIncluded:  true,
+		lowerIncluded:  false,
 		upperIncluded:  true,
 		begin:          2,
 		end:            7,
@@ -1023,7 +1043,7 @@ var testsParseInterval = []struct {
 		lowerUnbounded: false,
 		upperUnbounded: true,
 		lowerIncluded:  true,
-		upperIncluded:  true,
+		upperIncluded:  false,
 		begin:          2,
 		end:            7,
 	},
@@ -1031,8 +1051,8 @@ var testsParseInterval = []struct {
 		s:              " <|--=====--|>",
 		lowerUnbounded: true,
 		upperUnbounded: true,
-		lowerIncluded:  true,
-		upperIncluded:  true,
+		lowerIncluded:  false,
+		upperIncluded:  false,
 		begin:          2,
 		end:            7,
 	},
@@ -1104,7 +1124,7 @@ var testsParseInterval = []struct {
 		s:              " <|=====--|",
 		lowerUnbounded: true,
 		upperUnbounded: false,
-		lowerIncluded:  true,
+		lowerIncluded:  false,
 		upperIncluded:  true,
 		begin:          0,
 		end:            5,
@@ -1114,7 +1134,7 @@ var testsParseInterval = []struct {
 		lowerUnbounded: false,
 		upperUnbounded: true,
 		lowerIncluded:  true,
-		upperIncluded:  true,
+		upperIncluded:  false,
 		begin:          0,
 		end:            5,
 	},
@@ -1122,8 +1142,8 @@ var testsParseInterval = []struct {
 		s:              " <|=====--|>",
 		lowerUnbounded: true,
 		upperUnbounded: true,
-		lowerIncluded:  true,
-		upperIncluded:  true,
+		lowerIncluded:  false,
+		upperIncluded:  false,
 		begin:          0,
 		end:            5,
 	},
@@ -3132,3 +3152,821 @@ var testsHAS = []struct {
 	},
 	//----------------------------
 }
+
+func TestIntervalUnion(t *testing.T) {
+	testIntervalUnion[int](t)
+	testIntervalUnion[float64](t)
+}
+
+func testIntervalUnion[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalUnion {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.test.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			x, er := parseInterval[T](tc.test.x_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			want := tc.i_Union_x
+			if discrete[T]() && tc.i_Union_x_integer != nil {
+				want = tc.i_Union_x_integer
+			}
+			u, v := i.Union(x), x.Union(i)
+			if !equalIntervals(t, u.Intervals(), want) {
+				t.Errorf("want %s.Union(%s) = %v but is %s, counter: %v", i, x, want, u, tc.test.counter)
+				return
+			}
+			if !equalIntervals(t, v.Intervals(), want) {
+				t.Errorf("want %s.Union(%s) = %v but is %s, counter: %v", x, i, want, v, tc.test.counter)
+				return
+			}
+		})
+	}
+}
+
+var testsIntervalUnion = []struct {
+	test      testGeneral
+	i_Union_x []string
+	// i_Union_x_integer is the union for integer types if it differs, when no integer lies between the intervals.
+	i_Union_x_integer []string
+}{
+	{
+		test:              testsGeneralSets[0],
+		i_Union_x:         []string{"  |=====|", "  |------=======-------|"},
+		i_Union_x_integer: []string{"  |=============|"},
+	},
+	{
+		test:      testsGeneralSets[1],
+		i_Union_x: []string{"  |=============|"},
+	},
+	{
+		test:      testsGeneralSets[3],
+		i_Union_x: []string{"  |------========|"},
+	},
+	{
+		test:      testsGeneralSets[8],
+		i_Union_x: []string{" *|=============|"},
+	},
+	{
+		test:      testsGeneralSets[11],
+		i_Union_x: []string{"  |=============|"},
+	},
+	{
+		test: testGeneral{
+			i_interval_string: "  |======--------------|* ",
+			x_interval_string: " *|------=======-------|  ",
+			counter:           "u0",
+		},
+		i_Union_x: []string{"  |======|*", " *|------=======|"},
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " <|======--------------|  ",
+			x_interval_string: "  |----------=======----|> ",
+			counter:           "u1",
+		},
+		i_Union_x: []string{" <|======|", "  |----------=======|>"},
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " <|======--------------|  ",
+			x_interval_string: "  |---=======----|> ",
+			counter:           "u2",
+		},
+		i_Union_x: []string{" <|======|>"},
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " *|----&|* ",
+			x_interval_string: "  |---=======----|  ",
+			counter:           "u3",
+		},
+		i_Union_x: []string{"  |---=======|"},
+	},
+}
+
+func TestIntervalGap(t *testing.T) {
+	testIntervalGap[int](t)
+	testIntervalGap[float64](t)
+}
+
+func testIntervalGap[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalGap {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.test.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			x, er := parseInterval[T](tc.test.x_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			wg, er := parseInterval[T](tc.i_Gap_x)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			for _, g := range []IInterval[T]{i.Gap(x), x.Gap(i)} {
+				if wg == nil && g != nil || wg != nil && (g == nil || !g.Equal(wg)) {
+					t.Errorf("want %s.Gap(%s) = %v but is %v, counter: %v", i, x, tc.i_Gap_x, g, tc.test.counter)
+					return
+				}
+			}
+		})
+	}
+}
+
+var testsIntervalGap = []struct {
+	test    testGeneral
+	i_Gap_x string
+}{
+	{
+		test: testGeneral{
+			i_interval_string: "  |=====---------------|  ",
+			x_interval_string: "  |-------=======-------|  ",
+			counter:           "g3",
+		},
+		i_Gap_x: " *|-----==|* ",
+	},
+	{
+		test:    testsGeneralSets[1],
+		i_Gap_x: "",
+	},
+	{
+		test:    testsGeneralSets[3],
+		i_Gap_x: "",
+	},
+	{
+		test:    testsGeneralSets[8],
+		i_Gap_x: "",
+	},
+	{
+		test:    testsGeneralSets[11],
+		i_Gap_x: "",
+	},
+	{
+		test: testGeneral{
+			i_interval_string: "  |======--------------|* ",
+			x_interval_string: " *|------=======-------|  ",
+			counter:           "g0",
+		},
+		i_Gap_x: "  |------&|  ",
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " <|====----------------|* ",
+			x_interval_string: "  |----------=======----|> ",
+			counter:           "g1",
+		},
+		i_Gap_x: "  |----======|* ",
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " <|======--------------|  ",
+			x_interval_string: "  |---=======----|> ",
+			counter:           "g2",
+		},
+		i_Gap_x: "",
+	},
+}
+
+func TestIntervalOverlaps(t *testing.T) {
+	testIntervalOverlaps[int](t)
+	testIntervalOverlaps[float64](t)
+}
+
+// testIntervalOverlaps checks that two intervals overlap exactly when the intersection in testsIntervalIntersect is not empty.
+func testIntervalOverlaps[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalIntersect {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.test.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			x, er := parseInterval[T](tc.test.x_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			we, er := parseInterval[T](tc.i_intersect_x)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			want := we != nil && !we.IsEmpty()
+			if i.Overlaps(x) != want || x.Overlaps(i) != want {
+				t.Errorf("want %s.Overlaps(%s) = %v (result conform test) but is %v, counter: %v",
+					i, x, want, i.Overlaps(x), tc.test.counter)
+				return
+			}
+			if i.Disjoint(x) == want || x.Disjoint(i) == want {
+				t.Errorf("want %s.Disjoint(%s) = %v (result conform test) but is %v, counter: %v",
+					i, x, !want, i.Disjoint(x), tc.test.counter)
+				return
+			}
+		})
+	}
+}
+
+func TestIntervalAbuts(t *testing.T) {
+	testIntervalAbuts[int](t)
+	testIntervalAbuts[float64](t)
+}
+
+func testIntervalAbuts[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalAbuts {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.test.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			x, er := parseInterval[T](tc.test.x_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			want := tc.i_Abuts_x || discrete[T]() && tc.integers_adjoin
+			if a, b := i.Abuts(x), x.Abuts(i); a != want || b != want {
+				t.Errorf("want %s.Abuts(%s) = %v (result conform test) but is %v, %v, counter: %v",
+					i, x, want, a, b, tc.test.counter)
+			}
+		})
+	}
+}
+
+func TestIntervalAbutsIntegers(t *testing.T) {
+	for n, tc := range []struct {
+		i, x IInterval[int]
+		want bool
+	}{
+		{Closed(0, 4), Closed(5, 9), true},
+		{ClosedOpen(0, 4), OpenClosed(3, 9), true},
+		{ClosedOpen(0, 5), OpenClosed(3, 9), false},
+		{Closed(0, 4), Closed(6, 9), false},
+		{Closed(0, 5), Closed(5, 9), false},
+		{Closed(0, math.MaxInt), Closed(5, 9), false},
+	} {
+		if a, b := tc.i.Abuts(tc.x), tc.x.Abuts(tc.i); a != tc.want || b != tc.want {
+			t.Errorf("want %s.Abuts(%s) = %v but is %v, %v, counter: %v", tc.i, tc.x, tc.want, a, b, n)
+		}
+	}
+}
+
+var testsIntervalAbuts = []struct {
+	test      testGeneral
+	i_Abuts_x bool
+	// integers_adjoin is true if the intervals abut for integer types only, no integer lying between them.
+	integers_adjoin bool
+}{
+	{
+		test:            testsGeneralSets[0],
+		i_Abuts_x:       false,
+		integers_adjoin: true,
+	},
+	{
+		test:      testsGeneralSets[1],
+		i_Abuts_x: false,
+	},
+	{
+		test:      testsGeneralSets[4],
+		i_Abuts_x: false,
+	},
+	{
+		test:      testsGeneralSets[8],
+		i_Abuts_x: false,
+	},
+	{
+		test:      testsGeneralSets[11],
+		i_Abuts_x: true,
+	},
+	{
+		test: testGeneral{
+			i_interval_string: "  |====----|  ",
+			x_interval_string: " *|----====|  ",
+			counter:           "a0",
+		},
+		i_Abuts_x: true,
+	},
+	{
+		test: testGeneral{
+			i_interval_string: "  |====----|* ",
+			x_interval_string: " *|----====|  ",
+			counter:           "a1",
+		},
+		i_Abuts_x: false,
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " <|====----|* ",
+			x_interval_string: "  |----====|> ",
+			counter:           "a2",
+		},
+		i_Abuts_x: true,
+	},
+	{
+		test: testGeneral{
+			i_interval_string: " *|----&|* ",
+			x_interval_string: " *|----====|  ",
+			counter:           "a3",
+		},
+		i_Abuts_x: false,
+	},
+}
+
+func TestIntervalIsEmpty(t *testing.T) {
+	for n, tc := range testsIntervalIsEmpty {
+		i, er := parseInterval[int](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if i.IsEmpty() != tc.emptyInt {
+			t.Errorf("want %s.IsEmpty() = %v for int but is %v, counter: %v", i, tc.emptyInt, i.IsEmpty(), n)
+		}
+		f, er := parseInterval[float64](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if f.IsEmpty() != tc.emptyFloat {
+			t.Errorf("want %s.IsEmpty() = %v for float64 but is %v, counter: %v", f, tc.emptyFloat, f.IsEmpty(), n)
+		}
+	}
+}
+
+func TestIntervalIsPoint(t *testing.T) {
+	for n, tc := range testsIntervalIsPoint {
+		i, er := parseInterval[int](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if i.IsPoint() != tc.pointInt {
+			t.Errorf("want %s.IsPoint() = %v for int but is %v, counter: %v", i, tc.pointInt, i.IsPoint(), n)
+		}
+		f, er := parseInterval[float64](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if f.IsPoint() != tc.pointFloat {
+			t.Errorf("want %s.IsPoint() = %v for float64 but is %v, counter: %v", f, tc.pointFloat, f.IsPoint(), n)
+		}
+	}
+	if p := Point(2.5); !p.IsPoint() || !p.Has(2.5) || p.Has(2.4) {
+		t.Errorf("want Point(2.5) to have only 2.5 but is %s", p)
+	}
+}
+
+func TestIntervalLength(t *testing.T) {
+	for n, tc := range []struct {
+		s      string
+		length int
+		ok     bool
+	}{
+		{"  |---====|  ", 4, true},
+		{" *|---====|* ", 4, true},
+		{" *|---&|* ", 0, true},
+		{" <|---====|  ", 0, false},
+		{"  |---====|> ", 0, false},
+	} {
+		i, er := parseInterval[int](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		if l, ok := i.Length(); l != tc.length || ok != tc.ok {
+			t.Errorf("want %s.Length() = %v, %v for int but is %v, %v, counter: %v", i, tc.length, tc.ok, l, ok, n)
+		}
+		f, er := parseInterval[float64](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		want := float64(tc.length)
+		if !tc.ok {
+			want = math.Inf(1)
+		}
+		if l, ok := f.Length(); l != want || ok != tc.ok {
+			t.Errorf("want %s.Length() = %v, %v for float64 but is %v, %v, counter: %v", f, want, tc.ok, l, ok, n)
+		}
+	}
+}
+
+func TestIntervalMidpoint(t *testing.T) {
+	for _, tc := range []struct {
+		i        *Interval[int64]
+		midpoint int64
+		ok       bool
+	}{
+		{NewInterval[int64](0, 10, true, false, true, false), 5, true},
+		{NewInterval[int64](3, 4, true, false, true, false), 3, true},
+		{NewInterval[int64](-4, -3, true, false, true, false), -4, true},
+		{NewInterval[int64](-3, 0, true, false, true, false), -2, true},
+		{NewInterval[int64](math.MinInt64, math.MaxInt64, true, false, true, false), -1, true},
+		{NewInterval[int64](math.MinInt64, math.MinInt64+2, true, false, true, false), math.MinInt64 + 1, true},
+		{NewInterval[int64](math.MaxInt64-2, math.MaxInt64, true, false, true, false), math.MaxInt64 - 1, true},
+		{NewInterval[int64](0, 10, true, true, true, false), 0, false},
+		{NewInterval[int64](3, 3, false, false, false, false), 0, false},
+	} {
+		if m, ok := tc.i.Midpoint(); m != tc.midpoint || ok != tc.ok {
+			t.Errorf("want %s.Midpoint() = %v, %v but is %v, %v", tc.i, tc.midpoint, tc.ok, m, ok)
+		}
+	}
+	u := NewInterval[uint8](250, 254, true, false, true, false)
+	if m, _ := u.Midpoint(); m != 252 {
+		t.Errorf("want %s.Midpoint() = 252 but is %v", u, m)
+	}
+	f := NewInterval(-math.MaxFloat64, math.MaxFloat64, true, false, true, false)
+	if m, _ := f.Midpoint(); m != 0 {
+		t.Errorf("want %s.Midpoint() = 0 but is %v", f, m)
+	}
+	if m, _ := NewInterval(1.0, 2, true, false, true, false).Midpoint(); m != 1.5 {
+		t.Errorf("want [1, 2].Midpoint() = 1.5 but is %v", m)
+	}
+}
+
+var testsIntervalIsPoint = []struct {
+	s          string
+	pointInt   bool
+	pointFloat bool
+}{
+	{"  |---&|  ", true, true},
+	{" *|---&|  ", false, false},
+	{" *|---=|* ", false, false},
+	{" *|---=|  ", true, false},
+	{"  |---=|* ", true, false},
+	{" *|---==|* ", true, false},
+	{"  |---==|* ", false, false},
+	{" <|---&|  ", false, false},
+}
+
+var testsIntervalIsEmpty = []struct {
+	s          string
+	emptyInt   bool
+	emptyFloat bool
+}{
+	{"  |---&|  ", false, false},
+	{" *|---&|  ", true, true},
+	{"  |---&|* ", true, true},
+	{" *|---=|* ", true, false},
+	{" *|---=|  ", false, false},
+	{"  |---=|* ", false, false},
+	{" *|---==|* ", false, false},
+	{" <|---=|* ", false, false},
+	{" *|---=|> ", false, false},
+}
+
+func TestIntervalExpand(t *testing.T) {
+	testIntervalExpand[int](t)
+	testIntervalExpand[float64](t)
+}
+
+func testIntervalExpand[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalExpand {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			we, er := parseInterval[T](tc.expanded)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			e := i.Expand(T(tc.lowerPad), T(tc.upperPad))
+			if we == nil && e != nil || we != nil && (e == nil || !e.Equal(we)) {
+				t.Errorf("want %s.Expand(%v, %v) = %v but is %v, counter: %v", i, tc.lowerPad, tc.upperPad, tc.expanded, e, n)
+			}
+		})
+	}
+}
+
+var testsIntervalExpand = []struct {
+	i_interval_string string
+	lowerPad          int
+	upperPad          int
+	expanded          string
+}{
+	{"  |----====|  ", 2, 3, "  |--=========|  "},
+	{" *|----====|* ", -1, -1, " *|-----==|* "},
+	{"  |----====|  ", -2, -2, "  |------&|  "},
+	{"  |----====|* ", -2, -2, ""},
+	{" <|----====|  ", 2, 3, " <|----=======|  "},
+	{"  |----====|> ", 2, 3, "  |--======|> "},
+}
+
+func TestIntervalClampTo(t *testing.T) {
+	testIntervalClampTo[int](t)
+	testIntervalClampTo[float64](t)
+}
+
+func testIntervalClampTo[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalClampTo {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			window, er := parseInterval[T](tc.window)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			wc, er := parseInterval[T](tc.clamped)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			c := i.ClampTo(window)
+			if c == nil || wc == nil && (!c.IsEmpty() || c.Lower() != T(tc.anchor)) || wc != nil && !c.Equal(wc) {
+				t.Errorf("want %s.ClampTo(%s) = %v at %v but is %v, counter: %v", i, window, tc.clamped, tc.anchor, c, n)
+			}
+		})
+	}
+}
+
+var testsIntervalClampTo = []struct {
+	i_interval_string string
+	window            string
+	clamped           string
+	anchor            int
+}{
+	{"  |--======|  ", "  |----========|* ", "  |----====|  ", 0},
+	{" <|======|* ", "  |----========|* ", "  |----==|* ", 0},
+	{"  |==|  ", "  |----========|* ", "", 4},
+	{"  |-------------------==|  ", "  |----========|* ", "", 12},
+	{"  |-------------==|  ", " <|----========|  ", "", 12},
+	{" *|------&|* ", "  |----========|* ", "", 6},
+	{" *|&|* ", "  |----========|* ", "", 4},
+}
+
+func TestIntervalContainsDiscrete(t *testing.T) {
+	for _, tc := range []struct {
+		i, x     *Interval[int]
+		contains bool
+	}{
+		{Point(3), NewInterval(3, 4, true, false, false, false), true},
+		{NewInterval(2, 6, false, false, false, false), NewInterval(3, 5, true, false, true, false), true},
+		{NewInterval(3, 5, true, false, true, false), NewInterval(2, 6, false, false, false, false), true},
+		{NewInterval(3, 5, true, false, true, false), NewInterval(2, 6, false, false, true, false), false},
+	} {
+		if c := tc.i.Contains(tc.x); c != tc.contains {
+			t.Errorf("want %s.Contains(%s) = %v but is %v", tc.i, tc.x, tc.contains, c)
+		}
+	}
+}
+
+func TestIntervalCompare(t *testing.T) {
+	testIntervalCompare[int](t)
+	testIntervalCompare[float64](t)
+}
+
+func testIntervalCompare[T constraints.Integer | constraints.Float](t *testing.T) {
+	var intervals []IInterval[T]
+	for _, s := range testsIntervalCompare {
+		x, er := parseInterval[T](s)
+		if er != nil {
+			t.Errorf(er.Error())
+			return
+		}
+		intervals = append(intervals, x)
+	}
+	for n, i := range intervals {
+		for m, x := range intervals {
+			want := 0
+			if n < m {
+				want = -1
+			} else if n > m {
+				want = 1
+			}
+			if c := i.Compare(x); c != want {
+				t.Errorf("want %s.Compare(%s) = %d but is %d", i, x, want, c)
+			}
+		}
+	}
+	sorted := slices.Clone(intervals)
+	slices.Reverse(sorted)
+	slices.SortFunc(sorted, func(a, b IInterval[T]) int { return a.Compare(b) })
+	if !slices.Equal(sorted, intervals) {
+		t.Errorf("want slices.SortFunc with Compare to sort %v but get %v", intervals, sorted)
+	}
+	if i := intervals[0]; i.Compare(nil) != 1 {
+		t.Errorf("want %s.Compare(nil) = 1 but is %d", i, i.Compare(nil))
+	}
+}
+
+// testsIntervalCompare is ascending.
+var testsIntervalCompare = []string{
+	" <|==|* ",
+	" <|==|  ",
+	" <|==|> ",
+	"  |==|* ",
+	"  |==|  ",
+	"  |===|  ",
+	"  |==|> ",
+	" *|==|* ",
+	" *|==|> ",
+	"  |-==|  ",
+}
+
+func TestIntervalClone(t *testing.T) {
+	i := NewInterval(0, 5, true, false, false, true)
+	c := i.Clone()
+	if !c.Equal(i) || c == IInterval[int](i) {
+		t.Errorf("want %s.Clone() to be an equal copy but is %s", i, c)
+	}
+	c.SetLower(2)
+	c.SetUpperUnbounded(false)
+	if i.Lower() != 0 || !i.UpperUnbounded() {
+		t.Errorf("want changing a clone to leave the original but it is %s", i)
+	}
+}
+
+func TestIntervalWith(t *testing.T) {
+	i := NewInterval(0, 5, true, false, false, false)
+	for _, tc := range []struct {
+		got  IInterval[int]
+		want *Interval[int]
+	}{
+		{i.WithLower(2, false), NewInterval(2, 5, false, false, false, false)},
+		{i.WithUpper(7, true), NewInterval(0, 7, true, false, true, false)},
+		{i.WithLowerUnbounded(), NewInterval(0, 5, false, true, false, false)},
+		{i.WithUpperUnbounded(), NewInterval(0, 0, true, false, false, true)},
+		{i.WithUpperUnbounded().WithUpper(3, true), NewInterval(0, 3, true, false, true, false)},
+	} {
+		if !tc.got.Equal(tc.want) {
+			t.Errorf("want %s but get %s", tc.want, tc.got)
+		}
+	}
+	if !i.Equal(NewInterval(0, 5, true, false, false, false)) {
+		t.Errorf("want the With methods to leave [0, 5) but it is %s", i)
+	}
+}
+
+func TestIntervalNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		i, normal *Interval[int]
+	}{
+		{NewInterval(2, 5, false, false, false, false), NewInterval(3, 4, true, false, true, false)},
+		{NewInterval(3, 4, true, false, true, false), NewInterval(3, 4, true, false, true, false)},
+		{NewInterval(2, 5, false, true, false, false), NewInterval(0, 4, false, true, true, false)},
+		{NewInterval(2, 5, false, false, false, true), NewInterval(3, 0, true, false, false, true)},
+		{NewInterval(4, 5, true, false, false, false), Point(4)},
+		{NewInterval(4, 5, false, false, false, false), nil},
+	} {
+		n := tc.i.Normalize()
+		if tc.normal == nil && n != nil || tc.normal != nil && (n == nil || !n.Equal(tc.normal)) {
+			t.Errorf("want %s.Normalize() = %v but is %v", tc.i, tc.normal, n)
+		}
+		if tc.normal != nil && !tc.i.EqualNormalized(tc.normal) {
+			t.Errorf("want %s.EqualNormalized(%s) to be true", tc.i, tc.normal)
+		}
+	}
+	if f := NewInterval(2.0, 5, false, false, false, false); !f.Normalize().Equal(f) || f.EqualNormalized(NewInterval(3.0, 4, true, false, true, false)) {
+		t.Errorf("want Normalize to leave %s and EqualNormalized to tell it from [3, 4]", f)
+	}
+	if e := NewInterval(4, 5, false, false, false, false); !e.EqualNormalized(NewInterval(7, 7, true, false, false, false)) || e.EqualNormalized(Point(4)) {
+		t.Errorf("want EqualNormalized to be true for two empty intervals only")
+	}
+}
+
+func TestIntervalToHalfOpen(t *testing.T) {
+	for _, tc := range []struct {
+		i, halfOpen, closed *Interval[int]
+	}{
+		{NewInterval(2, 4, false, false, true, false), NewInterval(3, 5, true, false, false, false), NewInterval(3, 4, true, false, true, false)},
+		{NewInterval(3, 5, true, false, false, false), NewInterval(3, 5, true, false, false, false), NewInterval(3, 4, true, false, true, false)},
+		{Point(3), NewInterval(3, 4, true, false, false, false), Point(3)},
+		{NewInterval(0, 4, false, true, true, false), NewInterval(0, 5, false, true, false, false), NewInterval(0, 4, false, true, true, false)},
+		{NewInterval(2, 0, false, false, false, true), NewInterval(3, 0, true, false, false, true), NewInterval(3, 0, true, false, false, true)},
+		{NewInterval(0, math.MaxInt, true, false, true, false), NewInterval(0, math.MaxInt, true, false, true, false), NewInterval(0, math.MaxInt, true, false, true, false)},
+	} {
+		if h := tc.i.ToHalfOpen(); h == nil || !h.Equal(tc.halfOpen) {
+			t.Errorf("want %s.ToHalfOpen() = %s but is %v", tc.i, tc.halfOpen, h)
+		}
+		if c := tc.i.ToClosed(); c == nil || !c.Equal(tc.closed) {
+			t.Errorf("want %s.ToClosed() = %s but is %v", tc.i, tc.closed, c)
+		}
+	}
+	if h := NewInterval(3, 3, true, false, false, false).ToHalfOpen(); h != nil {
+		t.Errorf("want ToHalfOpen of an empty interval to be nil but is %v", h)
+	}
+	if f := NewInterval(2.0, 4, false, false, true, false); !f.ToHalfOpen().Equal(f) {
+		t.Errorf("want ToHalfOpen to leave %s", f)
+	}
+}
+
+func TestIntervalZero(t *testing.T) {
+	testIntervalZero[int](t)
+	testIntervalZero[float64](t)
+}
+
+func testIntervalZero[T constraints.Integer | constraints.Float](t *testing.T) {
+	type holder struct {
+		window Interval[T]
+	}
+	var h holder
+	z := &h.window
+	x := Closed[T](1, 5)
+	if !z.IsEmpty() || z.IsPoint() || z.Has(0) || !z.Equal(Empty[T]()) || z.String() != "empty" {
+		t.Errorf("want the zero interval to be the empty interval but get %s", z)
+	}
+	if z.Contains(x) || !x.Contains(z) || z.Overlaps(x) || x.Overlaps(z) || !z.Disjoint(x) || z.Abuts(x) {
+		t.Errorf("want the zero interval to relate to %s as the empty set", x)
+	}
+	if in := z.Intersect(x); in != nil {
+		t.Errorf("want the zero interval to intersect %s to nil but is %s", x, in)
+	}
+	if lower, upper := z.Subtract(x); lower != nil || upper != nil {
+		t.Errorf("want %s subtracted from the zero interval to be nil but is %v and %v", x, lower, upper)
+	}
+	if lower, upper := x.Subtract(z); lower != nil || upper == nil || !upper.Equal(x) {
+		t.Errorf("want the zero interval subtracted from %s to be %s but is %v and %v", x, x, lower, upper)
+	}
+	if e := z.Encompass(x); !e.Equal(x) {
+		t.Errorf("want the zero interval encompassing %s to be %s but is %s", x, x, e)
+	}
+	if n, ok := z.Length(); n != 0 || !ok {
+		t.Errorf("want the zero interval to have length 0 but get %v, %v", n, ok)
+	}
+	if z.Normalize() != nil || z.Move(1) != nil || z.Expand(1, 1) != nil || z.Gap(x) != nil || len(z.Partition(0)) != 0 {
+		t.Errorf("want operations on the zero interval to be nil")
+	}
+	for c := range z.Chunks(2) {
+		t.Errorf("want the zero interval to have no chunks but get %s", c)
+	}
+}
+
+func TestIntervalNil(t *testing.T) {
+	var n *Interval[int]
+	x := Closed(1, 5)
+	if !n.IsEmpty() || n.Has(1) || n.Contains(x) || !x.Contains(n) || n.Overlaps(x) || x.Overlaps(n) || n.String() != "empty" {
+		t.Errorf("want a nil interval to be the empty set")
+	}
+	if !n.Equal(Empty[int]()) || !Empty[int]().Equal(n) || !n.Equal(nil) || x.Equal(n) {
+		t.Errorf("want a nil interval to equal only empty intervals")
+	}
+	if n.Intersect(x) != nil || x.Intersect(n) != nil || n.Gap(x) != nil || n.Adjoin(x) != nil {
+		t.Errorf("want operations with a nil interval to be nil")
+	}
+	if e := n.Encompass(x); !e.Equal(x) {
+		t.Errorf("want a nil interval encompassing %s to be %s but is %s", x, x, e)
+	}
+	if lower, upper := x.Subtract(n); lower != nil || upper == nil || !upper.Equal(x) {
+		t.Errorf("want a nil interval subtracted from %s to be %s but is %v and %v", x, x, lower, upper)
+	}
+	if in := OrEmpty(x.Intersect(Closed(7, 9))).Intersect(Closed(2, 3)); in != nil {
+		t.Errorf("want the chained intersection of disjoint intervals to be nil but is %s", in)
+	}
+	if in := OrEmpty(x.Intersect(Closed(2, 9))).Intersect(Closed(0, 3)); in == nil || !in.Equal(Closed(2, 3)) {
+		t.Errorf("want the chained intersection to be [2, 3] but is %v", in)
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	for _, tc := range []struct {
+		i    IInterval[float64]
+		want string
+	}{
+		{Closed(1.0, 5), "[1,5]"},
+		{ClosedOpen(1.0, 5), "[1,5)"},
+		{OpenClosed(2.5, 7.25), "(2.5,7.25]"},
+		{Open(2.5, 7.25), "(2.5,7.25)"},
+		{AtMost(3.0), "(-∞,3]"},
+		{Greater(-1.5), "(-1.5,+∞)"},
+		{All[float64](), "(-∞,+∞)"},
+		{NewInterval(0, 5.0, true, true, true, false), "(-∞,5]"},
+		{ClosedOpen(3.0, 3), "empty"},
+	} {
+		if s := tc.i.String(); s != tc.want {
+			t.Errorf("want String() = %s but get %s", tc.want, s)
+		}
+		x, rest, er := scanIntervalText[float64](tc.want)
+		if er != nil || rest != "" || !x.Equal(tc.i) {
+			t.Errorf("want %s to be read back but get %v, %q, %v", tc.want, x, rest, er)
+		}
+	}
+	for s, want := range map[string]IInterval[float64]{
+		"<(0, 5]":  AtMost(5.0),
+		"[0, 5)>":  AtLeast(0.0),
+		"<(0, 5)>": All[float64](),
+		"(,5]":     AtMost(5.0),
+		"[0,∞)":    AtLeast(0.0),
+		"[0, 5.5)": ClosedOpen(0, 5.5),
+	} {
+		if x, _, er := scanIntervalText[float64](s); er != nil || !x.Equal(want) {
+			t.Errorf("want %s to be read as %s but get %v, %v", s, want, x, er)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package interval
+
+import (
+	"encoding/json"
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// jsonInterval is the JSON form of an interval, like {"v":2,"lower":0,"upper":5,"lowerIncluded":true,
+// "upperIncluded":false}, with null for the bound of an unbounded side.
+type jsonInterval[T constraints.Integer | constraints.Float] struct {
+	V             int  `json:"v"`
+	Lower         *T   `json:"lower"`
+	Upper         *T   `json:"upper"`
+	LowerIncluded bool `json:"lowerIncluded"`
+	UpperIncluded bool `json:"upperIncluded"`
+}
+
+// MarshalJSON implements json.Marshaler. An empty interval is written as null. It has a value receiver, so that
+// intervals in structs are written also when they are not addressable.
+func (i Interval[T]) MarshalJSON() ([]byte, error) {
+	if i.IsEmpty() {
+		return []byte("null"), nil
+	}
+	j := jsonInterval[T]{V: SchemaVersion, LowerIncluded: i.lower.Kind == ClosedBound, UpperIncluded: i.upper.Kind == ClosedBound}
+	if i.lower.Kind != Unbounded {
+		j.Lower = &i.lower.Value
+	}
+	if i.upper.Kind != Unbounded {
+		j.Upper = &i.upper.Value
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null is read as the empty interval, and JSON without v as written
+// before it was versioned. Bounds which do not make a valid interval return the errors of NewIntervalChecked.
+func (i *Interval[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*i = Interval[T]{}
+		return nil
+	}
+	var j jsonInterval[T]
+	if er := json.Unmarshal(data, &j); er != nil {
+		return fmt.Errorf("interval: %w", er)
+	}
+	if er := CheckSchemaVersion(j.V); er != nil {
+		return er
+	}
+	var lower, upper T
+	if j.Lower != nil {
+		lower = *j.Lower
+	}
+	if j.Upper != nil {
+		upper = *j.Upper
+	}
+	x, er := NewIntervalChecked(lower, upper, j.LowerIncluded, j.Lower == nil, j.UpperIncluded, j.Upper == nil)
+	if er != nil {
+		return er
+	}
+	*i = *x
+	return nil
+}
@@ -0,0 +1,58 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIntervalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		i    *Interval[float64]
+		json string
+	}{
+		{ClosedOpen(0.0, 5), `{"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}`},
+		{OpenClosed(-2.5, 7.25), `{"v":2,"lower":-2.5,"upper":7.25,"lowerIncluded":false,"upperIncluded":true}`},
+		{AtMost(3.0), `{"v":2,"lower":null,"upper":3,"lowerIncluded":false,"upperIncluded":true}`},
+		{All[float64](), `{"v":2,"lower":null,"upper":null,"lowerIncluded":false,"upperIncluded":false}`},
+		{Empty[float64](), `null`},
+	} {
+		b, er := json.Marshal(tc.i)
+		if er != nil || string(b) != tc.json {
+			t.Errorf("want json.Marshal(%s) = %s but get %s, %v", tc.i, tc.json, b, er)
+		}
+		var x Interval[float64]
+		if er := json.Unmarshal([]byte(tc.json), &x); er != nil || !x.Equal(tc.i) {
+			t.Errorf("want json.Unmarshal(%s) = %s but get %s, %v", tc.json, tc.i, &x, er)
+		}
+	}
+	type payload struct {
+		Window  Interval[int64]
+		Pointer *Interval[int64]
+	}
+	p := payload{Window: *Closed[int64](1, 9007199254740993), Pointer: AtLeast[int64](3)}
+	b, er := json.Marshal(p)
+	if er != nil {
+		t.Fatalf("want a payload to be marshalled but get %v", er)
+	}
+	var q payload
+	if er := json.Unmarshal(b, &q); er != nil || !q.Window.Equal(&p.Window) || !q.Pointer.Equal(p.Pointer) {
+		t.Errorf("want %s to be read back as %s and %s but get %s and %s, %v", b, &p.Window, p.Pointer, &q.Window, q.Pointer, er)
+	}
+	var x Interval[int]
+	if er := json.Unmarshal([]byte(`{"lower":0,"upper":5,"lowerIncluded":true}`), &x); er != nil || !x.Equal(ClosedOpen(0, 5)) {
+		t.Errorf("want unversioned JSON to be read as [0,5) but get %s, %v", &x, er)
+	}
+	for s, want := range map[string]error{
+		`{"v":3,"lower":0,"upper":5}`:                         ErrSchemaVersion,
+		`{"v":2,"lower":5,"upper":0}`:                         ErrReversedBounds,
+		`{"v":2,"lower":null,"upper":0,"lowerIncluded":true}`: ErrUnboundedIncluded,
+	} {
+		if er := json.Unmarshal([]byte(s), &x); !errors.Is(er, want) {
+			t.Errorf("want json.Unmarshal(%s) to fail with %v but get %v", s, want, er)
+		}
+	}
+	if er := json.Unmarshal([]byte(`{"v":2,"lower":"a"}`), &x); er == nil {
+		t.Errorf("want json.Unmarshal of a string bound to fail")
+	}
+}
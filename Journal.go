@@ -0,0 +1,64 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// JournalOp is the kind of change recorded in a JournalEntry.
+type JournalOp int
+
+const (
+	// JournalAdd is an IntervalSet.Add, or an IntervalMap.Set or Apply.
+	JournalAdd JournalOp = iota
+	// JournalRemove is an IntervalSet.Remove or an IntervalMap.Delete.
+	JournalRemove
+)
+
+func (op JournalOp) String() string {
+	if op == JournalRemove {
+		return "remove"
+	}
+	return "add"
+}
+
+// JournalEntry records one change: the operation with its operand, and the values it really added to or removed
+// from the coverage, which may be less than the operand.
+type JournalEntry[T constraints.Integer | constraints.Float] struct {
+	Op      JournalOp
+	Operand IInterval[T]
+	Change[T]
+}
+
+// Journal is a log of the changes of an IntervalSet or the coverage of an IntervalMap, in the order they were made.
+type Journal[T constraints.Integer | constraints.Float] struct {
+	entries []JournalEntry[T]
+}
+
+func NewJournal[T constraints.Integer | constraints.Float]() *Journal[T] {
+	return new(Journal[T])
+}
+
+// Entries returns the recorded changes, oldest first.
+func (j *Journal[T]) Entries() []JournalEntry[T] {
+	return append([]JournalEntry[T](nil), j.entries...)
+}
+
+// Replay applies the recorded changes in order to s. Replayed on an empty set, it rebuilds the journaled set or the
+// coverage of the journaled map.
+func (j *Journal[T]) Replay(s *IntervalSet[T]) {
+	for _, e := range j.entries {
+		if e.Op == JournalRemove {
+			s.Remove(e.Operand)
+		} else {
+			s.Add(e.Operand)
+		}
+	}
+}
+
+// record appends a change, if there is a journal to record it in.
+func (j *Journal[T]) record(op JournalOp, operand IInterval[T], c Change[T]) {
+	if j == nil || operand == nil {
+		return
+	}
+	j.entries = append(j.entries, JournalEntry[T]{Op: op, Operand: copyOf(operand), Change: c})
+}
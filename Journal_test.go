@@ -0,0 +1,70 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestJournal(t *testing.T) {
+	s := new(IntervalSet[int])
+	j := NewJournal[int]()
+	s.SetJournal(j)
+	s.Add(NewInterval(0, 10, true, false, false, false))
+	s.Add(NewInterval(5, 15, true, false, false, false))
+	s.Remove(NewInterval(8, 12, true, false, true, false))
+	s.Remove(NewInterval(20, 30, true, false, true, false))
+	entries := j.Entries()
+	if len(entries) != 4 {
+		t.Fatalf("want 4 journal entries but get %d", len(entries))
+	}
+	want := []struct {
+		op      JournalOp
+		added   []IInterval[int]
+		removed []IInterval[int]
+	}{
+		{JournalAdd, []IInterval[int]{NewInterval(0, 10, true, false, false, false)}, nil},
+		{JournalAdd, []IInterval[int]{NewInterval(10, 15, true, false, false, false)}, nil},
+		{JournalRemove, nil, []IInterval[int]{NewInterval(8, 12, true, false, true, false)}},
+		{JournalRemove, nil, nil},
+	}
+	for n, e := range entries {
+		if e.Op != want[n].op || !sameIntervals(e.Added, want[n].added) || !sameIntervals(e.Removed, want[n].removed) {
+			t.Errorf("want journal entry %d to %s %v and remove %v but get %s %v and %v", n, want[n].op, want[n].added, want[n].removed, e.Op, e.Added, e.Removed)
+		}
+	}
+	replayed := new(IntervalSet[int])
+	j.Replay(replayed)
+	if replayed.String() != s.String() {
+		t.Errorf("want replay to rebuild %s but get %s", s, replayed)
+	}
+
+	m := NewIntervalMap[int, string]()
+	mj := NewJournal[int]()
+	m.SetJournal(mj)
+	m.Set(NewInterval(0, 10, true, false, false, false), "a")
+	m.Set(NewInterval(5, 15, true, false, false, false), "b")
+	m.Delete(NewInterval(0, 5, true, false, false, false))
+	entries = mj.Entries()
+	if len(entries) != 3 || !sameIntervals(entries[1].Added, []IInterval[int]{NewInterval(10, 15, true, false, false, false)}) ||
+		!sameIntervals(entries[2].Removed, []IInterval[int]{NewInterval(0, 5, true, false, false, false)}) {
+		t.Errorf("want map journal to record coverage changes but get %v", entries)
+	}
+	coverage := new(IntervalSet[int])
+	mj.Replay(coverage)
+	if coverage.String() != "{[5,15)}" {
+		t.Errorf("want replay of map journal to rebuild coverage {[5,15)} but get %s", coverage)
+	}
+}
+
+// sameIntervals returns true if a and b hold equal intervals in the same order.
+func sameIntervals[T constraints.Integer | constraints.Float](a, b []IInterval[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for n := range a {
+		if !a[n].Equal(b[n]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Key is an interval as a comparable value, to be used as a map key or for deduplication. Intervals which are Equal
+// have the same Key: the bound of an unbounded side is left zero and every empty interval is the zero Key.
+type Key[T constraints.Integer | constraints.Float] struct {
+	Lower, Upper                  T
+	LowerIncluded, LowerUnbounded bool
+	UpperIncluded, UpperUnbounded bool
+}
+
+// Key returns receiver interval as a comparable value.
+func (i *Interval[T]) Key() Key[T] {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return Key[T]{}
+	}
+	k := Key[T]{LowerUnbounded: i.lower.Kind == Unbounded, UpperUnbounded: i.upper.Kind == Unbounded}
+	if i.lower.Kind != Unbounded {
+		k.Lower, k.LowerIncluded = i.lower.Value, i.lower.Kind == ClosedBound
+	}
+	if i.upper.Kind != Unbounded {
+		k.Upper, k.UpperIncluded = i.upper.Value, i.upper.Kind == ClosedBound
+	}
+	return k
+}
+
+// Interval returns the interval of k, which is empty for the zero Key.
+func (k Key[T]) Interval() *Interval[T] {
+	return NewInterval[T](k.Lower, k.Upper, k.LowerIncluded, k.LowerUnbounded, k.UpperIncluded, k.UpperUnbounded)
+}
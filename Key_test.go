@@ -0,0 +1,41 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestIntervalKey(t *testing.T) {
+	testIntervalKey[int](t)
+	testIntervalKey[float64](t)
+}
+
+func testIntervalKey[T constraints.Integer | constraints.Float](t *testing.T) {
+	for _, tc := range testsGeneralSets {
+		t.Run(tc.counter, func(t *testing.T) {
+			i, _ := parseInterval[T](tc.i_interval_string)
+			x, _ := parseInterval[T](tc.x_interval_string)
+			if (i.Key() == x.Key()) != i.Equal(x) {
+				t.Errorf("want %s.Key() == %s.Key() to be %v as Equal, counter: %v", i, x, i.Equal(x), tc.counter)
+			}
+			if k := i.Key(); !k.Interval().Equal(i) || k.Interval().Key() != k {
+				t.Errorf("want %s.Key().Interval() = %s but is %s, counter: %v", i, i, k.Interval(), tc.counter)
+			}
+		})
+	}
+	seen := map[Key[T]]int{}
+	for _, i := range []*Interval[T]{
+		NewInterval[T](0, 5, true, false, false, false),
+		NewInterval[T](0, 5, true, false, false, false),
+		NewInterval[T](0, 5, true, false, true, false),
+		NewInterval[T](0, 5, true, false, false, true),
+		NewInterval[T](0, 9, true, false, true, true),
+		NewInterval[T](3, 3, true, false, false, false),
+		NewInterval[T](5, 2, true, false, true, false),
+	} {
+		seen[i.Key()]++
+	}
+	if len(seen) != 4 || seen[Key[T]{}] != 2 || seen[NewInterval[T](0, 0, true, false, false, true).Key()] != 2 {
+		t.Errorf("want the keys of 7 intervals to deduplicate to 4 but get %v", seen)
+	}
+}
@@ -0,0 +1,118 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math/rand"
+)
+
+// Intervals form a lattice, with nil, the empty interval, as its bottom. Meet and Join are its operations; they are
+// commutative, associative and absorb each other, as CheckLatticeLaws verifies.
+
+// Meet returns the greatest interval within both receiver interval and x_interval_string interval, their
+// intersection, or nil if they have no value in common.
+func (i *Interval[T]) Meet(x IInterval[T]) IInterval[T] {
+	return i.Intersect(x)
+}
+
+// Join returns the smallest interval which contains both receiver interval and x_interval_string interval. Unlike
+// Encompass it changes neither operand. If both are empty nil is returned.
+func (i *Interval[T]) Join(x IInterval[T]) IInterval[T] {
+	switch {
+	case i.IsEmpty() && (x == nil || x.IsEmpty()):
+		return nil
+	case x == nil || x.IsEmpty():
+		return copyOf[T](i)
+	case i.IsEmpty():
+		return copyOf(x)
+	}
+	return span[T](i, x)
+}
+
+// IntersectAll returns the intersection of all intervals, or nil as soon as one is empty or they have no value in
+// common. Without intervals it returns All, which constrains nothing.
+func IntersectAll[T constraints.Integer | constraints.Float](intervals ...IInterval[T]) IInterval[T] {
+	var r IInterval[T] = All[T]()
+	for _, x := range intervals {
+		if r = meet(r, x); r == nil {
+			return nil
+		}
+	}
+	return r
+}
+
+// EncompassAll returns the smallest interval which contains all intervals, as Join, or nil if they are all empty.
+// Empty and nil intervals are skipped, and none of the intervals is changed.
+func EncompassAll[T constraints.Integer | constraints.Float](intervals ...IInterval[T]) IInterval[T] {
+	var r IInterval[T]
+	for _, x := range intervals {
+		r = join(r, x)
+	}
+	return r
+}
+
+// CheckLatticeLaws tests the lattice laws on n random triples of intervals made by generate, and returns an error
+// naming the first law which does not hold and the intervals it fails for. generate may return nil for the empty
+// interval, and intervals of its own IInterval implementation to check that against the laws too.
+func CheckLatticeLaws[T constraints.Integer | constraints.Float](r *rand.Rand, n int, generate func(r *rand.Rand) IInterval[T]) error {
+	for k := 0; k < n; k++ {
+		a, b, c := generate(r), generate(r), generate(r)
+		laws := []struct {
+			name        string
+			left, right IInterval[T]
+		}{
+			{"meet is commutative", meet(a, b), meet(b, a)},
+			{"join is commutative", join(a, b), join(b, a)},
+			{"meet is associative", meet(a, meet(b, c)), meet(meet(a, b), c)},
+			{"join is associative", join(a, join(b, c)), join(join(a, b), c)},
+			{"meet absorbs join", meet(a, join(a, b)), a},
+			{"join absorbs meet", join(a, meet(a, b)), a},
+			{"meet is idempotent", meet(a, a), a},
+			{"join is idempotent", join(a, a), a},
+		}
+		for _, law := range laws {
+			if !equalOrEmpty(law.left, law.right) {
+				return fmt.Errorf("interval: %s does not hold for a = %v, b = %v, c = %v: %v is not %v",
+					law.name, a, b, c, law.left, law.right)
+			}
+		}
+	}
+	return nil
+}
+
+// meet is Meet with nil as the empty interval.
+func meet[T constraints.Integer | constraints.Float](a, b IInterval[T]) IInterval[T] {
+	if a == nil || b == nil {
+		return nil
+	}
+	return a.Meet(b)
+}
+
+// join is Join with nil as the empty interval.
+func join[T constraints.Integer | constraints.Float](a, b IInterval[T]) IInterval[T] {
+	if a == nil {
+		if b == nil {
+			return nil
+		}
+		return b.Join(nil)
+	}
+	return a.Join(b)
+}
+
+// equalOrEmpty returns true if a and b are equal, taking nil as the empty interval.
+func equalOrEmpty[T constraints.Integer | constraints.Float](a, b IInterval[T]) bool {
+	if a == nil || a.IsEmpty() {
+		return b == nil || b.IsEmpty()
+	}
+	return a.Equal(b)
+}
+
+// randomInterval returns a random interval with bounds from -10 to 10, which may be empty, a point, open or
+// unbounded.
+func randomInterval[T constraints.Integer | constraints.Float](r *rand.Rand) IInterval[T] {
+	lower, upper := T(r.Intn(21)-10), T(r.Intn(21)-10)
+	if r.Intn(2) == 0 && lower > upper {
+		lower, upper = upper, lower
+	}
+	return NewInterval[T](lower, upper, r.Intn(2) == 0, r.Intn(6) == 0, r.Intn(2) == 0, r.Intn(6) == 0)
+}
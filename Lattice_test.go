@@ -0,0 +1,49 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCheckLatticeLaws(t *testing.T) {
+	if er := CheckLatticeLaws(rand.New(rand.NewSource(1)), 10000, randomInterval[int]); er != nil {
+		t.Error(er)
+	}
+	if er := CheckLatticeLaws(rand.New(rand.NewSource(1)), 10000, randomInterval[float64]); er != nil {
+		t.Error(er)
+	}
+	broken := func(r *rand.Rand) IInterval[int] {
+		return brokenJoin{randomInterval[int](r).(*Interval[int])}
+	}
+	if er := CheckLatticeLaws(rand.New(rand.NewSource(1)), 10000, broken); er == nil {
+		t.Errorf("want CheckLatticeLaws to find a law broken by Join returning its receiver")
+	}
+}
+
+// brokenJoin is an interval whose Join does not include its argument.
+type brokenJoin struct {
+	*Interval[int]
+}
+
+func (b brokenJoin) Join(x IInterval[int]) IInterval[int] {
+	return b.Interval
+}
+
+func TestIntersectAllEncompassAll(t *testing.T) {
+	if got := IntersectAll[int](Closed(0, 10), AtLeast(3), Less(8)); !got.Equal(ClosedOpen(3, 8)) {
+		t.Errorf("want [3,8) but get %v", got)
+	}
+	if got := IntersectAll[int](Closed(0, 1), Closed(2, 3), nil); got != nil {
+		t.Errorf("want nil for disjoint intervals but get %v", got)
+	}
+	if got := IntersectAll[int](); !got.Equal(All[int]()) {
+		t.Errorf("want All without intervals but get %v", got)
+	}
+	a := Closed(0, 1)
+	if got := EncompassAll[int](a, nil, Open(5, 5), Open(4, 9)); !got.Equal(ClosedOpen(0, 9)) || !a.Equal(Closed(0, 1)) {
+		t.Errorf("want [0,9) without changing [0,1] but get %v and %v", got, a)
+	}
+	if got := EncompassAll[int](nil, Open(5, 5)); got != nil {
+		t.Errorf("want nil for empty intervals but get %v", got)
+	}
+}
@@ -0,0 +1,39 @@
+package interval
+
+import (
+	"math"
+)
+
+// Lerp returns the value at fraction t of receiver interval, lower at 0 and upper at 1, as for a colour ramp or the
+// position of a progress bar. Whether the bounds are included does not matter. A t outside [0, 1] gives a value
+// outside the interval, and for integer types the value is rounded to the nearest integer. Lerp returns 0 for an
+// empty or unbounded interval.
+func (i *Interval[T]) Lerp(t float64) T {
+	i = i.orEmpty()
+	if i.IsEmpty() || i.lower.Kind == Unbounded || i.upper.Kind == Unbounded {
+		return 0
+	}
+	switch t {
+	case 0:
+		return i.lower.Value
+	case 1:
+		return i.upper.Value
+	}
+	lower, upper := float64(i.lower.Value), float64(i.upper.Value)
+	return proportion[T](lower + t*(upper-lower))
+}
+
+// InvLerp returns the fraction of receiver interval at which v is, the inverse of Lerp: 0 at lower, 1 at upper, and
+// below 0 or above 1 for a value outside the interval. It returns 0 for a point and NaN for an empty or unbounded
+// interval, which has no fractions.
+func (i *Interval[T]) InvLerp(v T) float64 {
+	i = i.orEmpty()
+	if i.IsEmpty() || i.lower.Kind == Unbounded || i.upper.Kind == Unbounded {
+		return math.NaN()
+	}
+	lower, upper := float64(i.lower.Value), float64(i.upper.Value)
+	if lower == upper {
+		return 0
+	}
+	return (float64(v) - lower) / (upper - lower)
+}
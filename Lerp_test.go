@@ -0,0 +1,37 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntervalLerp(t *testing.T) {
+	for _, tc := range []struct {
+		t    float64
+		want float64
+	}{
+		{0, 10}, {1, 20}, {0.25, 12.5}, {-0.5, 5}, {2, 30},
+	} {
+		if got := Open(10.0, 20).Lerp(tc.t); got != tc.want {
+			t.Errorf("want (10,20).Lerp(%v) = %v but get %v", tc.t, tc.want, got)
+		}
+		if got := Open(10.0, 20).InvLerp(tc.want); got != tc.t {
+			t.Errorf("want (10,20).InvLerp(%v) = %v but get %v", tc.want, tc.t, got)
+		}
+	}
+	if got := Closed(0, 255).Lerp(0.5); got != 128 {
+		t.Errorf("want [0,255].Lerp(0.5) = 128 but get %d", got)
+	}
+	if got := Closed[int64](math.MinInt64, math.MaxInt64).Lerp(1); got != math.MaxInt64 {
+		t.Errorf("want the upper bound at 1 but get %d", got)
+	}
+	if got := AtLeast(0.0).Lerp(0.5); got != 0 {
+		t.Errorf("want 0 for an unbounded interval but get %v", got)
+	}
+	if got := Point(3).InvLerp(3); got != 0 {
+		t.Errorf("want 0 for a point but get %v", got)
+	}
+	if got := Open(3, 3).InvLerp(3); !math.IsNaN(got) {
+		t.Errorf("want NaN for an empty interval but get %v", got)
+	}
+}
@@ -0,0 +1,78 @@
+package interval
+
+import (
+	"math"
+	"time"
+)
+
+// Nanos is a time interval [Start, End) of nanoseconds since the Unix epoch, for hot paths such as per-request
+// checks. Unlike Interval[int64] it is a small value without flags, so its methods are a few comparisons which are
+// inlined and do not allocate. Start math.MinInt64 and End math.MaxInt64 stand for unbounded sides, which leaves
+// out the single instant math.MaxInt64, in the year 2262.
+type Nanos struct {
+	Start, End int64
+}
+
+// NewNanos returns the time interval [start, end) as Nanos.
+func NewNanos(start, end time.Time) Nanos {
+	return Nanos{Start: start.UnixNano(), End: end.UnixNano()}
+}
+
+// ToNanos returns the Nanos holding the same instants as x, and false if there is none because the upper bound of x
+// is an included math.MaxInt64. An empty x gives an empty Nanos, and an unbounded side the sentinel.
+func ToNanos(x IInterval[int64]) (Nanos, bool) {
+	if x == nil || x.IsEmpty() {
+		return Nanos{}, true
+	}
+	n := Nanos{Start: math.MinInt64, End: math.MaxInt64}
+	if !x.LowerUnbounded() {
+		n.Start = x.Lower()
+		if !x.LowerIncluded() {
+			if n.Start == math.MaxInt64 {
+				return Nanos{}, true
+			}
+			n.Start++
+		}
+	}
+	if x.UpperUnbounded() {
+		return n, true
+	}
+	n.End = x.Upper()
+	if x.UpperIncluded() {
+		if n.End == math.MaxInt64 {
+			return Nanos{}, false
+		}
+		n.End++
+	}
+	return n, true
+}
+
+// Interval returns n as a time interval, with the sentinel sides unbounded.
+func (n Nanos) Interval() *Interval[int64] {
+	return NewInterval[int64](n.Start, n.End, n.Start != math.MinInt64, n.Start == math.MinInt64, false, n.End == math.MaxInt64)
+}
+
+// IsEmpty returns true if n holds no instant, when Start is not before End.
+func (n Nanos) IsEmpty() bool {
+	return n.Start >= n.End
+}
+
+// Has returns true if instant t is in n.
+func (n Nanos) Has(t int64) bool {
+	return n.Start <= t && t < n.End
+}
+
+// Overlaps returns true if n and x have an instant in common.
+func (n Nanos) Overlaps(x Nanos) bool {
+	return max(n.Start, x.Start) < min(n.End, x.End)
+}
+
+// Contains returns true if every instant of x is in n.
+func (n Nanos) Contains(x Nanos) bool {
+	return x.IsEmpty() || n.Start <= x.Start && x.End <= n.End
+}
+
+// Intersect returns the instants n and x have in common, which is empty if they do not overlap.
+func (n Nanos) Intersect(x Nanos) Nanos {
+	return Nanos{Start: max(n.Start, x.Start), End: min(n.End, x.End)}
+}
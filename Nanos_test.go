@@ -0,0 +1,93 @@
+package interval
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestToNanos(t *testing.T) {
+	for _, tc := range []struct {
+		i  *Interval[int64]
+		n  Nanos
+		ok bool
+	}{
+		{NewInterval[int64](0, 5, true, false, false, false), Nanos{0, 5}, true},
+		{NewInterval[int64](0, 5, false, false, true, false), Nanos{1, 6}, true},
+		{NewInterval[int64](0, 5, true, true, false, false), Nanos{math.MinInt64, 5}, true},
+		{NewInterval[int64](0, 5, true, false, false, true), Nanos{0, math.MaxInt64}, true},
+		{NewInterval[int64](5, 5, true, false, false, false), Nanos{}, true},
+		{NewInterval[int64](0, math.MaxInt64, true, false, true, false), Nanos{}, false},
+		{NewInterval[int64](math.MaxInt64, 0, false, false, false, true), Nanos{}, true},
+	} {
+		n, ok := ToNanos(tc.i)
+		if n != tc.n || ok != tc.ok {
+			t.Errorf("want ToNanos(%s) = %v, %v but is %v, %v", tc.i, tc.n, tc.ok, n, ok)
+		}
+		if !ok || n.IsEmpty() {
+			continue
+		}
+		for v := int64(-2); v <= 7; v++ {
+			if n.Has(v) != tc.i.Has(v) {
+				t.Errorf("want %v.Has(%d) = %v as for %s", n, v, tc.i.Has(v), tc.i)
+			}
+		}
+		if back, _ := ToNanos(n.Interval()); back != n {
+			t.Errorf("want ToNanos(%v.Interval()) = %v but is %v", n, n, back)
+		}
+	}
+}
+
+func TestNanos(t *testing.T) {
+	start := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	a := NewNanos(start, start.Add(time.Hour))
+	b := NewNanos(start.Add(30*time.Minute), start.Add(2*time.Hour))
+	c := NewNanos(start.Add(time.Hour), start.Add(2*time.Hour))
+	if !a.Overlaps(b) || a.Overlaps(c) {
+		t.Errorf("want %v to overlap %v but not %v", a, b, c)
+	}
+	if i := a.Intersect(b); i != NewNanos(start.Add(30*time.Minute), start.Add(time.Hour)) {
+		t.Errorf("want %v.Intersect(%v) to be the half hour they share but is %v", a, b, i)
+	}
+	if !a.Intersect(c).IsEmpty() || !a.Contains(a.Intersect(c)) || a.Contains(b) {
+		t.Errorf("want %v to contain the empty interval and not %v", a, b)
+	}
+}
+
+// The benchmarks compare Nanos with Interval[int64] for an admission check: is the request time within the window.
+
+var benchmarkResult bool
+
+func BenchmarkHas(b *testing.B) {
+	start := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	generic := NewTimeInterval(start, start.Add(time.Hour))
+	fast, _ := ToNanos(generic)
+	now := start.Add(time.Minute).UnixNano()
+	b.Run("Interval", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			benchmarkResult = generic.Has(now + int64(k&1023))
+		}
+	})
+	b.Run("Nanos", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			benchmarkResult = fast.Has(now + int64(k&1023))
+		}
+	})
+}
+
+func BenchmarkOverlaps(b *testing.B) {
+	start := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	generic, other := NewTimeInterval(start, start.Add(time.Hour)), NewTimeInterval(start.Add(time.Hour), start.Add(2*time.Hour))
+	fast, _ := ToNanos(generic)
+	fastOther, _ := ToNanos(other)
+	b.Run("Interval", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			benchmarkResult = generic.Overlaps(other)
+		}
+	})
+	b.Run("Nanos", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			benchmarkResult = fast.Overlaps(fastOther)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Option changes a side of the interval made by New or MustInterval, which is closed by default.
+type Option func(*sides)
+
+// sides are the flags of an interval being made by New.
+type sides struct {
+	lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool
+}
+
+// WithOpenLower excludes the lower bound.
+func WithOpenLower() Option {
+	return func(s *sides) {
+		s.lowerIncluded = false
+	}
+}
+
+// WithOpenUpper excludes the upper bound.
+func WithOpenUpper() Option {
+	return func(s *sides) {
+		s.upperIncluded = false
+	}
+}
+
+// WithLowerUnbounded makes the lower side unbounded, ignoring lower.
+func WithLowerUnbounded() Option {
+	return func(s *sides) {
+		s.lowerIncluded, s.lowerUnbounded = false, true
+	}
+}
+
+// WithUpperUnbounded makes the upper side unbounded, ignoring upper.
+func WithUpperUnbounded() Option {
+	return func(s *sides) {
+		s.upperIncluded, s.upperUnbounded = false, true
+	}
+}
+
+// New returns the interval [lower, upper] changed by options, like New(0, 5, WithOpenUpper()) for [0, 5). It returns
+// the errors of NewIntervalChecked.
+func New[T constraints.Integer | constraints.Float](lower, upper T, options ...Option) (*Interval[T], error) {
+	s := sides{lowerIncluded: true, upperIncluded: true}
+	for _, o := range options {
+		o(&s)
+	}
+	return NewIntervalChecked(lower, upper, s.lowerIncluded, s.lowerUnbounded, s.upperIncluded, s.upperUnbounded)
+}
+
+// MustInterval is New, which panics if the interval is not valid, for fixed intervals as in tests.
+func MustInterval[T constraints.Integer | constraints.Float](lower, upper T, options ...Option) *Interval[T] {
+	i, er := New(lower, upper, options...)
+	if er != nil {
+		panic(er)
+	}
+	return i
+}
@@ -0,0 +1,37 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		options []Option
+		want    *Interval[int]
+	}{
+		{nil, NewInterval(0, 5, true, false, true, false)},
+		{[]Option{WithOpenLower()}, NewInterval(0, 5, false, false, true, false)},
+		{[]Option{WithOpenUpper()}, NewInterval(0, 5, true, false, false, false)},
+		{[]Option{WithOpenLower(), WithUpperUnbounded()}, NewInterval(0, 5, false, false, false, true)},
+		{[]Option{WithLowerUnbounded(), WithOpenUpper()}, NewInterval(0, 5, false, true, false, false)},
+		{[]Option{WithLowerUnbounded(), WithUpperUnbounded()}, NewInterval(0, 5, false, true, false, true)},
+	} {
+		i, er := New(0, 5, tc.options...)
+		if er != nil || !i.Equal(tc.want) {
+			t.Errorf("want New = %s but is %v, %v", tc.want, i, er)
+		}
+		if m := MustInterval(0, 5, tc.options...); !m.Equal(tc.want) {
+			t.Errorf("want MustInterval = %s but is %s", tc.want, m)
+		}
+	}
+	if _, er := New(5, 0); !errors.Is(er, ErrReversedBounds) {
+		t.Errorf("want New(5, 0) to fail with ErrReversedBounds but get %v", er)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("want MustInterval(5, 0) to panic")
+		}
+	}()
+	MustInterval(5, 0)
+}
@@ -0,0 +1,88 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Arithmetic on bounds of integer types can overflow at the extremes of T, as moving [0, MaxInt64] up. The helpers
+// here detect that, so operations can keep the values T can hold instead of wrapping around.
+
+// add returns v + d and whether it overflowed: 1 above the highest value of T, -1 below the lowest, 0 if it did not.
+func add[T constraints.Integer | constraints.Float](v, d T) (T, int) {
+	r := v + d
+	switch {
+	case d > 0 && r < v:
+		return r, 1
+	case d < 0 && r > v:
+		return r, -1
+	}
+	return r, 0
+}
+
+// sub returns v - d and whether it overflowed, as add.
+func sub[T constraints.Integer | constraints.Float](v, d T) (T, int) {
+	r := v - d
+	switch {
+	case d > 0 && r > v:
+		return r, -1
+	case d < 0 && r < v:
+		return r, 1
+	}
+	return r, 0
+}
+
+// extremes returns the lowest and highest value of integer type T.
+func extremes[T constraints.Integer | constraints.Float]() (lowest, highest T) {
+	highest = 1
+	for 2*highest > highest {
+		highest *= 2
+	}
+	highest += highest - 1
+	return -highest - 1, highest
+}
+
+// shifted returns receiver interval with its bounded sides moved to lower and upper, which overflowed as told by
+// lowerOverflow and upperOverflow. A side beyond the range of T is clipped to the extreme of T, included, and nil is
+// returned if no value of T is left.
+func (i *Interval[T]) shifted(lower T, lowerOverflow int, upper T, upperOverflow int) IInterval[T] {
+	lowest, highest := T(0), T(0)
+	if lowerOverflow != 0 || upperOverflow != 0 {
+		lowest, highest = extremes[T]()
+	}
+	r := copyOf[T](i)
+	if i.lower.Kind != Unbounded {
+		switch lowerOverflow {
+		case 1:
+			return nil
+		case -1:
+			lower, r.lower.Kind = lowest, ClosedBound
+		}
+		r.SetLower(lower)
+	}
+	if i.upper.Kind != Unbounded {
+		switch upperOverflow {
+		case -1:
+			return nil
+		case 1:
+			upper, r.upper.Kind = highest, ClosedBound
+		}
+		r.SetUpper(upper)
+	}
+	return maybeEmpty(r)
+}
+
+// mul returns v * w and whether it overflowed, as add.
+func mul[T constraints.Integer | constraints.Float](v, w T) (T, int) {
+	r := v * w
+	if !discrete[T]() || v == 0 {
+		return r, 0
+	}
+	lowest, _ := extremes[T]()
+	if r/v == w && !(lowest < 0 && (v+1 == 0 && w == lowest || w+1 == 0 && v == lowest)) {
+		return r, 0
+	}
+	if v > 0 == (w > 0) {
+		return r, 1
+	}
+	return r, -1
+}
@@ -0,0 +1,110 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExtremes(t *testing.T) {
+	if lo, hi := extremes[int8](); lo != math.MinInt8 || hi != math.MaxInt8 {
+		t.Errorf("want extremes of int8 = %d, %d but are %d, %d", math.MinInt8, math.MaxInt8, lo, hi)
+	}
+	if lo, hi := extremes[uint16](); lo != 0 || hi != math.MaxUint16 {
+		t.Errorf("want extremes of uint16 = 0, %d but are %d, %d", math.MaxUint16, lo, hi)
+	}
+	if lo, hi := extremes[int64](); lo != math.MinInt64 || hi != math.MaxInt64 {
+		t.Errorf("want extremes of int64 = %d, %d but are %d, %d", int64(math.MinInt64), int64(math.MaxInt64), lo, hi)
+	}
+	if lo, hi := extremes[uint64](); lo != 0 || hi != math.MaxUint64 {
+		t.Errorf("want extremes of uint64 = 0, %d but are %d, %d", uint64(math.MaxUint64), lo, hi)
+	}
+}
+
+func TestIntervalExtremes(t *testing.T) {
+	full := NewInterval[int64](math.MinInt64, math.MaxInt64, true, false, true, false)
+	top := NewInterval[int64](0, math.MaxInt64, false, false, true, false)
+	bottom := NewInterval[int64](math.MinInt64, 0, true, false, false, false)
+	if l, ok := full.Length(); ok || l != 0 {
+		t.Errorf("want the length of %s to overflow but is %d, %v", full, l, ok)
+	}
+	if l, ok := top.Length(); !ok || l != math.MaxInt64 {
+		t.Errorf("want the length of %s = MaxInt64 but is %d, %v", top, l, ok)
+	}
+	if m, ok := full.Midpoint(); !ok || m != -1 {
+		t.Errorf("want the midpoint of %s = -1 but is %d, %v", full, m, ok)
+	}
+	if a := bottom.Adjoin(NewInterval[int64](0, math.MaxInt64, true, false, true, false)); a == nil || !a.Equal(full) {
+		t.Errorf("want %s to adjoin [0, MaxInt64] to %s but is %v", bottom, full, a)
+	}
+	if lower, upper := full.Subtract(top); lower == nil || !lower.Equal(NewInterval[int64](math.MinInt64, 0, true, false, true, false)) || upper != nil {
+		t.Errorf("want %s minus %s = [MinInt64, 0] but is %v and %v", full, top, lower, upper)
+	}
+	if lower, upper := full.Subtract(Point[int64](math.MaxInt64)); lower == nil || lower.Upper() != math.MaxInt64 || lower.UpperIncluded() || upper != nil {
+		t.Errorf("want %s minus MaxInt64 = [MinInt64, MaxInt64) but is %v and %v", full, lower, upper)
+	}
+	if m := top.Move(10); m == nil || !m.Equal(NewInterval[int64](10, math.MaxInt64, false, false, true, false)) {
+		t.Errorf("want %s.Move(10) = (10, MaxInt64] but is %v", top, m)
+	}
+	if m := bottom.Move(-10); m == nil || !m.Equal(NewInterval[int64](math.MinInt64, -10, true, false, false, false)) {
+		t.Errorf("want %s.Move(-10) = [MinInt64, -10) but is %v", bottom, m)
+	}
+	if m := Point[int64](math.MaxInt64).Move(1); m != nil {
+		t.Errorf("want MaxInt64 moved up to be nil but is %v", m)
+	}
+	if e := top.Expand(1, 1); e == nil || !e.Equal(NewInterval[int64](-1, math.MaxInt64, false, false, true, false)) {
+		t.Errorf("want %s.Expand(1, 1) = (-1, MaxInt64] but is %v", top, e)
+	}
+	if e := bottom.Expand(math.MaxInt64, -math.MaxInt64); e == nil || !e.Equal(NewInterval[int64](math.MinInt64, math.MinInt64+1, true, false, false, false)) {
+		t.Errorf("want %s.Expand(MaxInt64, -MaxInt64) = [MinInt64, MinInt64+1) but is %v", bottom, e)
+	}
+	if e := Point[int64](-1).Expand(0, math.MinInt64); e != nil {
+		t.Errorf("want -1 narrowed beyond MinInt64 to be nil but is %v", e)
+	}
+	var chunks int
+	for c := range NewInterval[int64](math.MaxInt64-10, math.MaxInt64, true, false, true, false).Chunks(4) {
+		chunks++
+		if c.Upper() < c.Lower() {
+			t.Errorf("want chunks not to wrap around but get %s", c)
+		}
+	}
+	if chunks != 3 {
+		t.Errorf("want 3 chunks of [MaxInt64-10, MaxInt64] but get %d", chunks)
+	}
+	u := NewInterval[uint8](250, 255, false, false, true, false)
+	if m := u.Move(3); m == nil || !m.Equal(NewInterval[uint8](253, 255, false, false, true, false)) {
+		t.Errorf("want %s.Move(3) = (253, 255] but is %v", u, m)
+	}
+	if e := NewInterval[uint8](0, 5, true, false, true, false).Expand(1, 0); e == nil || !e.Equal(NewInterval[uint8](0, 5, true, false, true, false)) {
+		t.Errorf("want [0, 5].Expand(1, 0) to stay [0, 5] for uint8 but is %v", e)
+	}
+	if h := NewInterval[uint8](250, 255, false, false, true, false).ToHalfOpen(); h == nil || !h.Equal(NewInterval[uint8](251, 255, true, false, true, false)) {
+		t.Errorf("want (250, 255].ToHalfOpen() to keep 255 included but is %v", h)
+	}
+	if lo, hi, er := ToSliceRange(NewInterval(math.MaxInt, 0, false, false, false, true), 10); er == nil {
+		t.Errorf("want ToSliceRange((MaxInt, ...)) to be empty but is %d, %d", lo, hi)
+	}
+}
+
+func TestMul(t *testing.T) {
+	for _, tc := range []struct {
+		v, w     int8
+		want     int8
+		overflow int
+	}{
+		{3, 4, 12, 0},
+		{-3, 4, -12, 0},
+		{16, 8, 0, 1},
+		{-16, 8, -128, 0},
+		{-16, -8, 0, 1},
+		{16, -9, 112, -1},
+		{-1, -128, -128, 1},
+		{0, -128, 0, 0},
+	} {
+		if got, overflow := mul(tc.v, tc.w); overflow != tc.overflow || overflow == 0 && got != tc.want {
+			t.Errorf("want mul(%d, %d) = %d, %d but get %d, %d", tc.v, tc.w, tc.want, tc.overflow, got, overflow)
+		}
+	}
+	if _, overflow := mul[uint8](16, 16); overflow != 1 {
+		t.Errorf("want 16*16 of uint8 to overflow but get %d", overflow)
+	}
+}
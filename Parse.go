@@ -0,0 +1,121 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"slices"
+	"strings"
+)
+
+// ErrSyntax is returned for text which is not an interval, or interval set, in the notation of String.
+var ErrSyntax = errors.New("interval: invalid syntax")
+
+// Parse reads an interval in the notation of String, like [1,5), (-∞,3] or empty, so Parse(x.String()) equals x. An
+// unbounded side may also be left out, as in (,10] and [3,). Bounds which do not make a valid interval, like [5,1],
+// return the errors of NewIntervalChecked.
+func Parse[T constraints.Integer | constraints.Float](s string) (*Interval[T], error) {
+	x, rest, er := scanIntervalText[T](strings.TrimSpace(s))
+	if er != nil {
+		return nil, er
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("%w: unexpected %q after the interval in %q", ErrSyntax, rest, s)
+	}
+	return NewIntervalChecked(x.Lower(), x.Upper(), x.LowerIncluded(), x.LowerUnbounded(), x.UpperIncluded(), x.UpperUnbounded())
+}
+
+// ParseSet reads an interval set as written by IntervalSet.String, like {[1,3), [5,9)}, so it can be given in a
+// config file or log line; the spaces after the commas may be left out, as in {[1,3),[5,9)}. The intervals may be
+// given in any order and overlap, and are merged as by IntervalSet.Add. Bounds which do not make a valid interval
+// return the errors of NewIntervalChecked.
+func ParseSet[T constraints.Integer | constraints.Float](s string) (*IntervalSet[T], error) {
+	body := strings.TrimSpace(s)
+	if !strings.HasPrefix(body, "{") || !strings.HasSuffix(body, "}") {
+		return nil, fmt.Errorf("%w: set %q is not enclosed in '{' and '}'", ErrSyntax, s)
+	}
+	body = strings.TrimSpace(body[1 : len(body)-1])
+	set := new(IntervalSet[T])
+	for body != "" {
+		x, rest, er := scanIntervalText[T](body)
+		if er != nil {
+			return nil, er
+		}
+		if _, er = NewIntervalChecked(x.Lower(), x.Upper(), x.LowerIncluded(), x.LowerUnbounded(), x.UpperIncluded(), x.UpperUnbounded()); er != nil {
+			return nil, er
+		}
+		set.add(x)
+		rest = strings.TrimSpace(rest)
+		if rest != "" {
+			if rest[0] != ',' {
+				return nil, fmt.Errorf("%w: expected ',' between intervals in %q", ErrSyntax, s)
+			}
+			rest = strings.TrimSpace(rest[1:])
+			if rest == "" {
+				return nil, fmt.Errorf("%w: expected interval after ',' in %q", ErrSyntax, s)
+			}
+		}
+		body = rest
+	}
+	return set, nil
+}
+
+// scanIntervalText reads the interval at the begin of s, as written by String, and returns what follows it. An
+// unbounded side is written as -∞ or +∞, or left out. The notation of schema versions 0 and 1, with an unbounded side
+// marked by '<' or '>' around its bound, as in <(0, 5], is read too.
+func scanIntervalText[T constraints.Integer | constraints.Float](s string) (*Interval[T], string, error) {
+	if rest, found := strings.CutPrefix(s, "empty"); found {
+		return new(Interval[T]), rest, nil
+	}
+	x := new(Interval[T])
+	rest := s
+	lowerUnbounded := strings.HasPrefix(rest, "<")
+	if lowerUnbounded {
+		rest = rest[1:]
+	}
+	if rest == "" || (rest[0] != '[' && rest[0] != '(') {
+		return nil, "", fmt.Errorf("%w: expected '[' or '(' at the begin of %q", ErrSyntax, s)
+	}
+	lowerIncluded := rest[0] == '['
+	lower, rest, found := strings.Cut(rest[1:], ",")
+	if !found {
+		return nil, "", fmt.Errorf("%w: expected ',' between the bounds of %q", ErrSyntax, s)
+	}
+	end := strings.IndexAny(rest, "])")
+	if end == -1 {
+		return nil, "", fmt.Errorf("%w: expected ']' or ')' at the end of %q", ErrSyntax, s)
+	}
+	upper := rest[:end]
+	upperIncluded := rest[end] == ']'
+	rest = rest[end+1:]
+	upperUnbounded := strings.HasPrefix(rest, ">")
+	if upperUnbounded {
+		rest = rest[1:]
+	}
+	var er error
+	if x.lower, er = scanBound[T](lower, lowerIncluded, lowerUnbounded, "-∞"); er != nil {
+		return nil, "", fmt.Errorf("%w: lower bound %q of %q: %w", ErrSyntax, lower, s, er)
+	}
+	if x.upper, er = scanBound[T](upper, upperIncluded, upperUnbounded, "+∞", "∞"); er != nil {
+		return nil, "", fmt.Errorf("%w: upper bound %q of %q: %w", ErrSyntax, upper, s, er)
+	}
+	x.markInfinite()
+	return x, rest, nil
+}
+
+// scanBound reads the value of a side of an interval, which is unbounded if it is empty or one of infinities.
+func scanBound[T constraints.Integer | constraints.Float](s string, included, unbounded bool, infinities ...string) (Bound[T], error) {
+	s = strings.TrimSpace(s)
+	if s == "" || slices.Contains(infinities, s) {
+		return Bound[T]{Kind: Unbounded}, nil
+	}
+	var value T
+	var extra string
+	if n, er := fmt.Sscan(s, &value, &extra); n != 1 {
+		if n == 2 {
+			er = fmt.Errorf("unexpected %q after the value", extra)
+		}
+		return Bound[T]{}, er
+	}
+	return newBound(value, included, unbounded), nil
+}
@@ -0,0 +1,85 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for s, want := range map[string]*Interval[int]{
+		"[1,5)":    ClosedOpen(1, 5),
+		" (1, 5] ": OpenClosed(1, 5),
+		"(,10]":    AtMost(10),
+		"[3,)":     AtLeast(3),
+		"(-∞,+∞)":  All[int](),
+		"empty":    Empty[int](),
+		"(3,3)":    Empty[int](),
+	} {
+		if x, er := Parse[int](s); er != nil || !x.Equal(want) {
+			t.Errorf("want Parse(%q) = %s but get %v, %v", s, want, x, er)
+		}
+	}
+	for _, x := range []*Interval[float64]{Closed(1.5, 2.25), Open(-3.5, 1e21), AtLeast(0.1), Less(-7.0), All[float64](), Empty[float64]()} {
+		if y, er := Parse[float64](x.String()); er != nil || !y.Equal(x) {
+			t.Errorf("want Parse(%s.String()) = %s but get %v, %v", x, x, y, er)
+		}
+	}
+	for s, want := range map[string]error{
+		"":         ErrSyntax,
+		"1,5":      ErrSyntax,
+		"[1;5)":    ErrSyntax,
+		"[1,5":     ErrSyntax,
+		"[1,5)x":   ErrSyntax,
+		"[a,5)":    ErrSyntax,
+		"empty[":   ErrSyntax,
+		"[5,1]":    ErrReversedBounds,
+		"[1.5,2]":  ErrSyntax,
+		"(-∞,∞)":   nil,
+		"[0,+∞)>":  nil,
+		"<(0, 5]":  nil,
+		"{[0,5)}":  ErrSyntax,
+		"[+∞,5]":   ErrSyntax,
+		"(1 ,5 ]":  nil,
+		"[ 1 , 5]": nil,
+	} {
+		if _, er := Parse[int](s); !errors.Is(er, want) || (er == nil) != (want == nil) {
+			t.Errorf("want Parse(%q) to fail with %v but get %v", s, want, er)
+		}
+	}
+	if _, er := Parse[float64]("[NaN,5]"); !errors.Is(er, ErrNaN) {
+		t.Errorf("want Parse of a NaN bound to fail with ErrNaN but get %v", er)
+	}
+}
+
+func TestParseSet(t *testing.T) {
+	for _, tc := range []struct {
+		s, want string
+	}{
+		{"{[1,3),[5,9)}", "{[1,3), [5,9)}"},
+		{" { [5,9) , [1,3) } ", "{[1,3), [5,9)}"},
+		{"{[1,4], [3,6), empty}", "{[1,6)}"},
+		{"{(-∞,0], [10,)}", "{(-∞,0], [10,+∞)}"},
+		{"{}", "{}"},
+	} {
+		s, er := ParseSet[int](tc.s)
+		if er != nil || s.String() != tc.want {
+			t.Errorf("want ParseSet(%q) = %s but get %v, %v", tc.s, tc.want, s, er)
+		}
+		if again, er := ParseSet[int](s.String()); er != nil || !again.Equal(s) {
+			t.Errorf("want ParseSet(%q) = %s but get %v, %v", s, s, again, er)
+		}
+	}
+	for _, tc := range []struct {
+		s    string
+		want error
+	}{
+		{"[1,3)", ErrSyntax},
+		{"{[1,3) [5,9)}", ErrSyntax},
+		{"{[1,3),}", ErrSyntax},
+		{"{[5,1]}", ErrReversedBounds},
+	} {
+		if _, er := ParseSet[int](tc.s); !errors.Is(er, tc.want) {
+			t.Errorf("want ParseSet(%q) to return %v but get %v", tc.s, tc.want, er)
+		}
+	}
+}
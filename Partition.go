@@ -0,0 +1,174 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math"
+	"sort"
+)
+
+var (
+	// ErrPartitionEmpty is returned when a partition has a nil interval or an interval without values.
+	ErrPartitionEmpty = errors.New("interval: partition has an empty interval")
+	// ErrPartitionGap is returned when two consecutive intervals of a partition leave values uncovered between them.
+	ErrPartitionGap = errors.New("interval: gap in partition")
+	// ErrPartitionOverlap is returned when two consecutive intervals of a partition share values.
+	ErrPartitionOverlap = errors.New("interval: overlap in partition")
+	// ErrPartitionCoverage is returned when the partition does not begin or end exactly at the universe.
+	ErrPartitionCoverage = errors.New("interval: partition does not cover universe")
+)
+
+// ValidatePartition returns nil if intervals together cover exactly the universe interval, without gaps and
+// without overlap. The intervals may be given in any order. The returned error wraps one of the ErrPartition
+// errors and names the boundary which is wrong, so it can be reported to whoever wrote the partition. For integer
+// types bounds are compared by the integers they include, so [0, 4] and [5, 9] partition [0, 9] as (-1, 5) begins it.
+func ValidatePartition[T constraints.Integer | constraints.Float](intervals []IInterval[T], universe IInterval[T]) error {
+	for n, x := range intervals {
+		if x == nil || x.IsEmpty() {
+			return fmt.Errorf("%w: interval %d %v", ErrPartitionEmpty, n, x)
+		}
+	}
+	if universe == nil || universe.IsEmpty() {
+		if len(intervals) > 0 {
+			return fmt.Errorf("%w: universe is empty but partition has %d intervals", ErrPartitionCoverage, len(intervals))
+		}
+		return nil
+	}
+	if len(intervals) == 0 {
+		return fmt.Errorf("%w: no intervals for universe %s", ErrPartitionCoverage, universe)
+	}
+	order := sortedByLower(intervals)
+	first := intervals[order[0]]
+	if compareLower(included(first), included(universe)) != 0 {
+		return fmt.Errorf("%w: interval %d %s does not begin at lower bound of universe %s", ErrPartitionCoverage, order[0], first, universe)
+	}
+	for n := 1; n < len(order); n++ {
+		a, b := intervals[order[n-1]], intervals[order[n]]
+		switch partitionBoundary(a, b) {
+		case -1:
+			return fmt.Errorf("%w: between interval %d %s and interval %d %s", ErrPartitionGap, order[n-1], a, order[n], b)
+		case 1:
+			return fmt.Errorf("%w: between interval %d %s and interval %d %s", ErrPartitionOverlap, order[n-1], a, order[n], b)
+		}
+	}
+	last := intervals[order[len(order)-1]]
+	if compareUpper(included(last), included(universe)) != 0 {
+		return fmt.Errorf("%w: interval %d %s does not end at upper bound of universe %s", ErrPartitionCoverage, order[len(order)-1], last, universe)
+	}
+	return nil
+}
+
+// Assign divides the bounded target into consecutive pieces for workers, the length of piece n proportional to
+// weights[n], or for integer types the number of its values. Together the pieces partition target exactly; a worker
+// whose share is too small to hold a value gets nil. With nil weights all workers get an equal share. Assign returns nil if target is empty or unbounded, workers
+// is not positive, or weights does not hold a non-negative weight for every worker with a positive sum.
+func Assign[T constraints.Integer | constraints.Float](target IInterval[T], workers int, weights []float64) []IInterval[T] {
+	if target == nil || target.IsEmpty() || target.LowerUnbounded() || target.UpperUnbounded() || workers <= 0 {
+		return nil
+	}
+	if weights == nil {
+		weights = make([]float64, workers)
+		for n := range weights {
+			weights[n] = 1
+		}
+	}
+	if len(weights) != workers {
+		return nil
+	}
+	var total float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil
+		}
+		total += w
+	}
+	if total <= 0 {
+		return nil
+	}
+	pieces := make([]IInterval[T], workers)
+	// The cuts are placed in float64, as the length of the target may be beyond the values of T.
+	length, begin := size(target), float64(included(target).Lower())
+	lower, lowerIncluded := target.Lower(), target.LowerIncluded()
+	var cumulative float64
+	for n, w := range weights {
+		cumulative += w
+		upper, upperIncluded := target.Upper(), target.UpperIncluded()
+		if n < workers-1 {
+			cut := begin + length*cumulative/total
+			if discrete[T]() {
+				cut = math.Round(cut)
+			}
+			upper, upperIncluded = target.Upper(), false
+			if cut < float64(target.Upper()) {
+				upper = max(T(cut), lower)
+			}
+		}
+		pieces[n] = maybeEmpty(NewInterval[T](lower, upper, lowerIncluded, false, upperIncluded, false))
+		if pieces[n] != nil {
+			lower, lowerIncluded = upper, true
+		}
+	}
+	return pieces
+}
+
+// proportion converts a part of a length to T, rounding to the nearest value for integer types.
+func proportion[T constraints.Integer | constraints.Float](part float64) T {
+	if discrete[T]() {
+		return T(math.Round(part))
+	}
+	return T(part)
+}
+
+// sortedByLower returns the indexes of intervals ordered by their begin, and by their end when they begin equally.
+// Nil intervals are ordered first.
+func sortedByLower[T constraints.Integer | constraints.Float](intervals []IInterval[T]) []int {
+	order := make([]int, len(intervals))
+	for n := range order {
+		order[n] = n
+	}
+	sort.SliceStable(order, func(p, q int) bool {
+		a, b := intervals[order[p]], intervals[order[q]]
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Compare(b) < 0
+	})
+	return order
+}
+
+// included returns x with its bounds replaced by the values they include for integer types, and x itself otherwise.
+func included[T constraints.Integer | constraints.Float](x IInterval[T]) IInterval[T] {
+	if discrete[T]() {
+		return closed(x)
+	}
+	return x
+}
+
+// partitionBoundary tells how the end of a meets the begin of the following interval b:
+// -1 if values between them are uncovered, 0 if they touch exactly and 1 if they share values. For integer types
+// bounds are compared as included, so [0, 4] touches [5, 9] as [0, 4] touches (4, 9], no integer lying between them.
+func partitionBoundary[T constraints.Integer | constraints.Float](a, b IInterval[T]) int {
+	if a.UpperUnbounded() || b.LowerUnbounded() {
+		return 1
+	}
+	if discrete[T]() {
+		a, b = closed(a), closed(b)
+		if a.Upper() < b.Lower() && a.Upper()+1 == b.Lower() {
+			return 0
+		}
+	}
+	if a.Upper() < b.Lower() {
+		return -1
+	}
+	if a.Upper() > b.Lower() {
+		return 1
+	}
+	if a.UpperIncluded() && b.LowerIncluded() {
+		return 1
+	}
+	if !a.UpperIncluded() && !b.LowerIncluded() {
+		return -1
+	}
+	return 0
+}
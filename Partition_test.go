@@ -0,0 +1,240 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math"
+	"testing"
+)
+
+func TestValidatePartition(t *testing.T) {
+	testValidatePartition[int](t)
+	testValidatePartition[float64](t)
+}
+
+func testValidatePartition[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsValidatePartition {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			universe, er := parseInterval[T](tc.universe)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			var intervals []IInterval[T]
+			for _, s := range tc.intervals {
+				x, er := parseInterval[T](s)
+				if er != nil {
+					t.Errorf(er.Error())
+					return
+				}
+				intervals = append(intervals, x)
+			}
+			er = ValidatePartition(intervals, IInterval[T](universe))
+			if !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) {
+				t.Errorf("want ValidatePartition(%v, %s) = %v but is %v, counter: %v", intervals, universe, tc.err, er, tc.counter)
+			}
+		})
+	}
+}
+
+var testsValidatePartition = []struct {
+	universe  string
+	intervals []string
+	err       error
+	counter   string
+}{
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", "  |----====|* ", "  |--------====|* "},
+		err:       nil,
+		counter:   "0",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |--------====|* ", "  |====|* ", "  |----====|* "},
+		err:       nil,
+		counter:   "1",
+	},
+	{
+		universe:  " <|============|> ",
+		intervals: []string{" <|====|* ", "  |----====|  ", " *|--------====|> "},
+		err:       nil,
+		counter:   "2",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", "  |-----===|* ", "  |--------====|* "},
+		err:       ErrPartitionGap,
+		counter:   "3",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", " *|----====|* ", "  |--------====|* "},
+		err:       ErrPartitionGap,
+		counter:   "4",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|  ", "  |----====|* ", "  |--------====|* "},
+		err:       ErrPartitionOverlap,
+		counter:   "5",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |=====|* ", "  |----====|* ", "  |--------====|* "},
+		err:       ErrPartitionOverlap,
+		counter:   "6",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{" *|====|* ", "  |----====|* ", "  |--------====|* "},
+		err:       ErrPartitionCoverage,
+		counter:   "7",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", "  |----====|* ", "  |--------====|  "},
+		err:       ErrPartitionCoverage,
+		counter:   "8",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", "  |----====|* "},
+		err:       ErrPartitionCoverage,
+		counter:   "9",
+	},
+	{
+		universe:  "  |============|* ",
+		intervals: []string{"  |====|* ", " *|----&|* ", "  |----========|* "},
+		err:       ErrPartitionEmpty,
+		counter:   "10",
+	},
+	{
+		universe:  " <|============|> ",
+		intervals: []string{" <|====|* ", " <|----====|> "},
+		err:       ErrPartitionOverlap,
+		counter:   "11",
+	},
+}
+
+func TestValidatePartitionIntegers(t *testing.T) {
+	for n, tc := range []struct {
+		intervals []IInterval[int]
+		universe  IInterval[int]
+		err       error
+	}{
+		{[]IInterval[int]{Closed(0, 4), Closed(5, 9)}, Closed(0, 9), nil},
+		{[]IInterval[int]{Closed(5, 9), Open(-1, 5)}, Closed(0, 9), nil},
+		{[]IInterval[int]{Closed(0, 4), Closed(6, 9)}, Closed(0, 9), ErrPartitionGap},
+		{[]IInterval[int]{Closed(0, 5), Closed(5, 9)}, Closed(0, 9), ErrPartitionOverlap},
+		{[]IInterval[int]{Closed(math.MinInt, -1), Closed(0, math.MaxInt)}, Closed(math.MinInt, math.MaxInt), nil},
+	} {
+		if er := ValidatePartition(tc.intervals, tc.universe); !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) {
+			t.Errorf("want ValidatePartition(%v, %s) = %v but is %v, counter: %v", tc.intervals, tc.universe, tc.err, er, n)
+		}
+	}
+}
+
+func TestAssign(t *testing.T) {
+	testAssign[int](t)
+	testAssign[float64](t)
+}
+
+func testAssign[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsAssign {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			target, er := parseInterval[T](tc.target)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			pieces := Assign(IInterval[T](target), tc.workers, tc.weights)
+			wantPieces := tc.pieces
+			if discrete[T]() && tc.integerPieces != nil {
+				wantPieces = tc.integerPieces
+			}
+			var nonEmpty []IInterval[T]
+			for k, p := range pieces {
+				if p == nil && wantPieces[k] != "" {
+					t.Errorf("want piece %d of Assign(%s, %d, %v) = %s but is nil, counter: %v", k, target, tc.workers, tc.weights, wantPieces[k], tc.counter)
+					return
+				}
+				if p != nil {
+					nonEmpty = append(nonEmpty, p)
+				}
+			}
+			var want []string
+			for _, s := range wantPieces {
+				if s != "" {
+					want = append(want, s)
+				}
+			}
+			if len(pieces) != len(wantPieces) || !equalIntervals(t, nonEmpty, want) {
+				t.Errorf("want Assign(%s, %d, %v) = %v but is %v, counter: %v", target, tc.workers, tc.weights, wantPieces, pieces, tc.counter)
+				return
+			}
+			if len(pieces) > 0 {
+				if er := ValidatePartition(nonEmpty, IInterval[T](target)); er != nil {
+					t.Errorf("want Assign(%s, %d, %v) to partition the target but get %v, counter: %v", target, tc.workers, tc.weights, er, tc.counter)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignIntegerRange(t *testing.T) {
+	if pieces := Assign[int8](Closed[int8](-100, 100), 2, nil); len(pieces) != 2 ||
+		!pieces[0].Equal(ClosedOpen[int8](-100, 1)) || !pieces[1].Equal(Closed[int8](1, 100)) {
+		t.Errorf("want Assign([-100,100], 2) = [[-100,1) [1,100]] for int8 but get %v", pieces)
+	}
+	full := Closed[int64](math.MinInt64, math.MaxInt64)
+	pieces := Assign[int64](full, 2, nil)
+	if len(pieces) != 2 || !pieces[0].Equal(ClosedOpen[int64](math.MinInt64, 0)) || !pieces[1].Equal(Closed[int64](0, math.MaxInt64)) {
+		t.Errorf("want Assign(%s, 2) split at 0 but get %v", full, pieces)
+	}
+	if er := ValidatePartition(pieces, IInterval[int64](full)); er != nil {
+		t.Errorf("want Assign(%s, 2) to partition it but get %v", full, er)
+	}
+}
+
+var testsAssign = []struct {
+	target  string
+	workers int
+	weights []float64
+	pieces  []string
+	// integerPieces are the pieces for integer types if they differ, which are divided by the number of values.
+	integerPieces []string
+	counter       string
+}{
+	{
+		target:        "  |============|  ",
+		workers:       3,
+		weights:       nil,
+		pieces:        []string{"  |====|* ", "  |----====|* ", "  |--------====|  "},
+		integerPieces: []string{"  |====|* ", "  |----=====|* ", "  |---------===|  "},
+		counter:       "0",
+	},
+	{
+		target:        " *|============|* ",
+		workers:       3,
+		weights:       []float64{1, 0, 2},
+		pieces:        []string{" *|====|* ", "", "  |----========|* "},
+		integerPieces: []string{" *|=====|* ", "", "  |-----=======|* "},
+		counter:       "1",
+	},
+	{
+		target:  "  |============|  ",
+		workers: 2,
+		weights: []float64{1},
+		pieces:  nil,
+		counter: "2",
+	},
+	{
+		target:  "  |============|> ",
+		workers: 2,
+		weights: nil,
+		pieces:  nil,
+		counter: "3",
+	},
+}
@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"math"
+	"time"
+)
+
+// Progress tracks which parts of a target range a long-running job has done, in any order.
+type Progress[T constraints.Integer | constraints.Float] struct {
+	target IInterval[T]
+	done   IntervalSet[T]
+}
+
+// NewProgress returns the progress of a job over target, which must be bounded to report a meaningful percentage.
+func NewProgress[T constraints.Integer | constraints.Float](target IInterval[T]) *Progress[T] {
+	progress := new(Progress[T])
+	progress.target = target
+	return progress
+}
+
+// Target returns the range of the job.
+func (p *Progress[T]) Target() IInterval[T] {
+	return p.target
+}
+
+// Done records x as done. Parts of x outside the target are ignored.
+func (p *Progress[T]) Done(x IInterval[T]) {
+	if p.target == nil || x == nil {
+		return
+	}
+	p.done.add(p.target.Intersect(x))
+}
+
+// DoneIntervals returns the parts of the target which are done, ascending.
+func (p *Progress[T]) DoneIntervals() []IInterval[T] {
+	return p.done.Intervals()
+}
+
+// Remaining returns the parts of the target which are not done yet, ascending.
+func (p *Progress[T]) Remaining() []IInterval[T] {
+	return p.done.uncovered(p.target)
+}
+
+// IsComplete returns true if the whole target is done.
+func (p *Progress[T]) IsComplete() bool {
+	return len(p.Remaining()) == 0
+}
+
+// Percent returns how much of the length of the target is done, from 0 to 100, or for integer types how many of its
+// values. A target without length is 100 percent done when it is complete; an unbounded target is never more than 0
+// percent done.
+func (p *Progress[T]) Percent() float64 {
+	total := size(p.target)
+	if math.IsInf(total, 1) {
+		return 0
+	}
+	if total == 0 {
+		if p.IsComplete() {
+			return 100
+		}
+		return 0
+	}
+	var done float64
+	for _, x := range p.done.intervals {
+		done += size(x)
+	}
+	return 100 * done / total
+}
+
+// ETA returns the time needed for the remaining parts at rate, the length of the target done per second or for integer
+// types the number of values. If the remaining parts are unbounded or the rate is not positive, the longest
+// time.Duration is returned.
+func (p *Progress[T]) ETA(rate float64) time.Duration {
+	var remaining float64
+	for _, x := range p.Remaining() {
+		remaining += size(x)
+	}
+	if remaining == 0 {
+		return 0
+	}
+	if math.IsInf(remaining, 1) || rate <= 0 {
+		return math.MaxInt64
+	}
+	return time.Duration(remaining / rate * float64(time.Second))
+}
+
+// Checkpoint returns the done parts of the target in a compact text form with its schema version, like
+// "v2 {[0,12.5)}", so they can be stored or sent to other workers and merged into a Progress again with Restore.
+func (p *Progress[T]) Checkpoint() string {
+	return versionText(p.done.String())
+}
+
+// Restore records the done parts of checkpoint, written by Checkpoint, as done. What was done before is kept, so
+// checkpoints of several workers can be merged in any order. Checkpoints written before they were versioned are
+// read too.
+func (p *Progress[T]) Restore(checkpoint string) error {
+	_, body, er := splitVersionText(checkpoint)
+	if er != nil {
+		return er
+	}
+	done, er := ParseSet[T](body)
+	if er != nil {
+		return er
+	}
+	for _, x := range done.intervals {
+		p.Done(x)
+	}
+	return nil
+}
+
+// size returns how much of a range x is: its length as measured by measure, or for integer types the number of its
+// values, as [0, 4] holds 5 and (3, 4) none.
+func size[T constraints.Integer | constraints.Float](x IInterval[T]) float64 {
+	if !discrete[T]() {
+		return measure(x)
+	}
+	switch {
+	case x == nil || x.IsEmpty():
+		return 0
+	case x.LowerUnbounded() || x.UpperUnbounded():
+		return math.Inf(1)
+	}
+	c := closed(x)
+	return float64(c.Upper()) - float64(c.Lower()) + 1
+}
+
+// measure returns the length of x as float64, 0 for nil and +Inf for an unbounded interval.
+func measure[T constraints.Integer | constraints.Float](x IInterval[T]) float64 {
+	if x == nil {
+		return 0
+	}
+	length, ok := x.Length()
+	switch {
+	case ok:
+		return float64(length)
+	case x.LowerUnbounded() || x.UpperUnbounded():
+		return math.Inf(1)
+	}
+	return float64(x.Upper()) - float64(x.Lower())
+}
@@ -0,0 +1,86 @@
+package interval
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgress(t *testing.T) {
+	p := NewProgress[int](NewInterval(0, 100, true, false, false, false))
+	p.Done(NewInterval(50, 75, true, false, false, false))
+	p.Done(NewInterval(90, 120, true, false, false, false))
+	p.Done(NewInterval(0, 10, true, false, false, false))
+	if v := p.Percent(); v != 45 {
+		t.Errorf("want Percent() = 45 but get %v", v)
+	}
+	want := []IInterval[int]{
+		NewInterval(10, 50, true, false, false, false),
+		NewInterval(75, 90, true, false, false, false),
+	}
+	if r := p.Remaining(); len(r) != len(want) || !r[0].Equal(want[0]) || !r[1].Equal(want[1]) {
+		t.Errorf("want Remaining() = %v but get %v", want, r)
+	}
+	if d := p.ETA(5.5); d != 10*time.Second {
+		t.Errorf("want ETA(5.5) = 10s but get %v", d)
+	}
+	p.Done(NewInterval(10, 90, true, false, false, false))
+	if !p.IsComplete() || p.Percent() != 100 || p.ETA(1) != 0 {
+		t.Errorf("want progress to be complete but remaining is %v", p.Remaining())
+	}
+
+	c := NewProgress[int](Closed(0, 9))
+	c.Done(Point(3))
+	if v := c.Percent(); v != 10 {
+		t.Errorf("want Percent() = 10 for one of ten integers but get %v", v)
+	}
+	c.Done(Closed(0, 4))
+	c.Done(Closed(5, 9))
+	if !c.IsComplete() || c.Percent() != 100 {
+		t.Errorf("want a complete integer target to be 100 percent done but get %v", c.Percent())
+	}
+	c = NewProgress[int](ClosedOpen(0, 10))
+	for v := range 10 {
+		c.Done(Point(v))
+	}
+	if !c.IsComplete() || c.Percent() != 100 {
+		t.Errorf("want a complete integer target to be 100 percent done but get %v", c.Percent())
+	}
+
+	u := NewProgress[float64](NewInterval(0.0, 0, true, false, false, true))
+	u.Done(NewInterval(0.0, 1000, true, false, false, false))
+	if u.Percent() != 0 || u.IsComplete() {
+		t.Errorf("want an unbounded target to be 0 percent done but get %v", u.Percent())
+	}
+}
+
+func TestProgressCheckpoint(t *testing.T) {
+	a := NewProgress[float64](NewInterval(0.0, 100, true, false, true, false))
+	a.Done(NewInterval(0.0, 12.5, true, false, false, false))
+	a.Done(NewInterval(50.0, 75, false, false, true, false))
+	b := NewProgress[float64](NewInterval(0.0, 100, true, false, true, false))
+	b.Done(NewInterval(12.5, 50, true, false, true, false))
+	b.Done(NewInterval(75.0, 100, false, false, true, false))
+	checkpoint := a.Checkpoint()
+	if checkpoint != "v2 {[0,12.5), (50,75]}" {
+		t.Errorf("want Checkpoint() = v2 {[0,12.5), (50,75]} but get %s", checkpoint)
+	}
+	if er := b.Restore(checkpoint); er != nil {
+		t.Fatalf("want Restore(%s) to succeed but get %v", checkpoint, er)
+	}
+	if !b.IsComplete() {
+		t.Errorf("want merged progress to be complete but remaining is %v", b.Remaining())
+	}
+	c := NewProgress[float64](NewInterval(0.0, 100, true, false, true, false))
+	if er := c.Restore("{[0, 12.5), (50, 75]}"); er != nil || c.Percent() != 37.5 {
+		t.Errorf("want Restore of an unversioned checkpoint to be 37.5 percent done but get %v, %v", c.Percent(), er)
+	}
+	d := NewProgress[float64](NewInterval(0.0, 100, true, false, true, false))
+	if er := d.Restore("v1 {<(0, 5], [95, 100)>}"); er != nil || d.Percent() != 10 {
+		t.Errorf("want Restore of a version 1 checkpoint to be 10 percent done but get %v, %v", d.Percent(), er)
+	}
+	for _, s := range []string{"[0,12.5)", "v3 {[0,12.5)}", "{[0,12.5) (50,75]}", "{[0,12.5),}", "{[0; 12.5)}", "{[x, 12.5)}"} {
+		if er := a.Restore(s); er == nil {
+			t.Errorf("want Restore(%s) to fail", s)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"slices"
+)
+
+// ErrRunOverflow is returned by ToRuns for a run of more values than a uint64 can count, the whole range of a 64-bit
+// type.
+var ErrRunOverflow = errors.New("interval: run longer than uint64")
+
+// ErrUnboundedSet is returned by ToValues for a set with an unbounded side, which has too many values to list.
+var ErrUnboundedSet = errors.New("interval: set is unbounded")
+
+// Run is Length consecutive integers from Start, the run-length encoding of an interval of integers as used by
+// bitmap indexes and genome masks.
+type Run[T constraints.Integer] struct {
+	Start  T
+	Length uint64
+}
+
+// ToRuns returns the runs of the integers of s, ascending. An unbounded side runs to the lowest or highest value of T.
+// It returns ErrRunOverflow for a run of the whole range of a 64-bit type.
+func ToRuns[T constraints.Integer](s *IntervalSet[T]) ([]Run[T], error) {
+	var runs []Run[T]
+	for x := range s.All() {
+		lower, upper := integerBounds(x)
+		length := uint64(upper) - uint64(lower) + 1
+		if length == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrRunOverflow, x)
+		}
+		if n := len(runs); n > 0 && uint64(lower)-uint64(runs[n-1].Start) == runs[n-1].Length {
+			runs[n-1].Length += length
+			continue
+		}
+		runs = append(runs, Run[T]{lower, length})
+	}
+	return runs, nil
+}
+
+// FromRuns returns the set of the integers of runs, which may be given in any order and overlap. Runs of length 0
+// are skipped, and a run beyond the highest value of T ends there.
+func FromRuns[T constraints.Integer](runs []Run[T]) *IntervalSet[T] {
+	_, highest := extremes[T]()
+	intervals := make([]IInterval[T], 0, len(runs))
+	for _, r := range runs {
+		if r.Length == 0 {
+			continue
+		}
+		upper := highest
+		if r.Length-1 <= uint64(highest)-uint64(r.Start) {
+			upper = r.Start + T(r.Length-1)
+		}
+		intervals = append(intervals, Closed(r.Start, upper))
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// ToValues returns every integer of s, ascending. It returns ErrUnboundedSet if s has an unbounded side.
+func ToValues[T constraints.Integer](s *IntervalSet[T]) ([]T, error) {
+	var values []T
+	for x := range s.All() {
+		if x.LowerUnbounded() || x.UpperUnbounded() {
+			return nil, fmt.Errorf("%w: %s", ErrUnboundedSet, x)
+		}
+		for v := range x.Values() {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// FromSortedValues returns the set of vs, merging consecutive integers into one interval in a single pass. Repeated
+// values are allowed; if vs is not ascending a sorted copy is used.
+func FromSortedValues[T constraints.Integer](vs []T) *IntervalSet[T] {
+	if !slices.IsSorted(vs) {
+		vs = slices.Sorted(slices.Values(vs))
+	}
+	var intervals []IInterval[T]
+	for n := 0; n < len(vs); {
+		lower, upper := vs[n], vs[n]
+		for n++; n < len(vs) && (vs[n] == upper || vs[n] == upper+1 && upper+1 > upper); n++ {
+			upper = vs[n]
+		}
+		intervals = append(intervals, Closed(lower, upper))
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// integerBounds returns the lowest and highest integer of x, which is not empty, taking an unbounded side to the
+// lowest or highest value of T.
+func integerBounds[T constraints.Integer](x IInterval[T]) (lower, upper T) {
+	c := closed(x)
+	lower, upper = extremes[T]()
+	if !c.LowerUnbounded() {
+		lower = c.Lower()
+	}
+	if !c.UpperUnbounded() {
+		upper = c.Upper()
+	}
+	return lower, upper
+}
@@ -0,0 +1,56 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRuns(t *testing.T) {
+	s := NewIntervalSet[int](Closed(1, 3), Closed(4, 6), Open(9, 12), Point(20))
+	runs, er := ToRuns(s)
+	if want := []Run[int]{{1, 6}, {10, 2}, {20, 1}}; er != nil || !slices.Equal(runs, want) {
+		t.Errorf("want runs %v but get %v, %v", want, runs, er)
+	}
+	if got := FromRuns(runs); !got.Equal(s) {
+		t.Errorf("want %s from runs but get %s", s, got)
+	}
+	if got := FromRuns([]Run[int]{{20, 1}, {0, 0}, {2, 3}, {1, 2}}); got.String() != "{[1,4], [20,20]}" {
+		t.Errorf("want {[1,4], [20,20]} but get %s", got)
+	}
+	small := NewIntervalSet[int8](AtMost[int8](-100), Greater[int8](120))
+	runs8, er := ToRuns(small)
+	if want := []Run[int8]{{-128, 29}, {121, 7}}; er != nil || !slices.Equal(runs8, want) {
+		t.Errorf("want runs %v but get %v, %v", want, runs8, er)
+	}
+	if got := FromRuns([]Run[int8]{{100, 1000}}); got.String() != "{[100,127]}" {
+		t.Errorf("want a run beyond int8 to end at 127 but get %s", got)
+	}
+	if _, er := ToRuns(NewIntervalSet[int64](All[int64]())); !errors.Is(er, ErrRunOverflow) {
+		t.Errorf("want %v but get %v", ErrRunOverflow, er)
+	}
+	if runs, er := ToRuns(NewIntervalSet[uint8](All[uint8]())); er != nil || !slices.Equal(runs, []Run[uint8]{{0, 256}}) {
+		t.Errorf("want one run of 256 but get %v, %v", runs, er)
+	}
+}
+
+func TestSetValues(t *testing.T) {
+	s := FromSortedValues([]int{1, 2, 3, 5, 7, 8, 8, 9})
+	if s.String() != "{[1,3], [5,5], [7,9]}" {
+		t.Errorf("want {[1,3], [5,5], [7,9]} but get %s", s)
+	}
+	if got := FromSortedValues([]int{9, 1, 2, 8}); got.String() != "{[1,2], [8,9]}" {
+		t.Errorf("want {[1,2], [8,9]} from unsorted values but get %s", got)
+	}
+	if got := FromSortedValues([]int8{126, 127}); got.String() != "{[126,127]}" {
+		t.Errorf("want {[126,127]} but get %s", got)
+	}
+	values, er := ToValues(s)
+	if want := []int{1, 2, 3, 5, 7, 8, 9}; er != nil || !slices.Equal(values, want) {
+		t.Errorf("want %v but get %v, %v", want, values, er)
+	}
+	if _, er := ToValues(NewIntervalSet[int](AtLeast(math.MaxInt - 1))); !errors.Is(er, ErrUnboundedSet) {
+		t.Errorf("want %v but get %v", ErrUnboundedSet, er)
+	}
+}
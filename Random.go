@@ -0,0 +1,88 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// RandomConfig tells GenerateRandom which intervals to make. Bounds are taken from Min to Max; each side is
+// unbounded with chance Unbounded, and an interval is empty with chance Empty and a point with chance Point. The
+// other sides are included or not with equal chance.
+type RandomConfig[T constraints.Integer | constraints.Float] struct {
+	Min, Max                T
+	Unbounded, Empty, Point float64
+}
+
+// DefaultRandomConfig returns the RandomConfig used by GenerateRandom without one: bounds from -10 to 10, or from
+// 0 for unsigned types, so that bounds are often equal, and chances of 1/6 for unbounded sides and 1/10 for empty
+// and point intervals.
+func DefaultRandomConfig[T constraints.Integer | constraints.Float]() RandomConfig[T] {
+	lowest, highest := randomRange[T](10)
+	return RandomConfig[T]{Min: lowest, Max: highest, Unbounded: 1.0 / 6, Empty: 0.1, Point: 0.1}
+}
+
+// GenerateRandom returns a random valid interval made by r as told by cfg, or by DefaultRandomConfig if it is not
+// given. Empty intervals are made in the ways a caller may meet them: with equal bounds which are not both included,
+// and for integer types also as an open interval between consecutive integers.
+func GenerateRandom[T constraints.Integer | constraints.Float](r *rand.Rand, cfg ...RandomConfig[T]) *Interval[T] {
+	c := DefaultRandomConfig[T]()
+	if len(cfg) > 0 {
+		c = cfg[0]
+	}
+	if c.Min > c.Max {
+		c.Min, c.Max = c.Max, c.Min
+	}
+	switch f := r.Float64(); {
+	case f < c.Empty:
+		v := randomValue(r, c)
+		if discrete[T]() && v < c.Max && r.Intn(2) == 0 {
+			return NewInterval[T](v, v+1, false, false, false, false)
+		}
+		lowerIncluded := r.Intn(2) == 0
+		return NewInterval[T](v, v, lowerIncluded, false, !lowerIncluded && r.Intn(2) == 0, false)
+	case f < c.Empty+c.Point:
+		return Point(randomValue(r, c))
+	}
+	lower, upper := randomValue(r, c), randomValue(r, c)
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	lowerUnbounded, upperUnbounded := r.Float64() < c.Unbounded, r.Float64() < c.Unbounded
+	return NewInterval[T](lower, upper, !lowerUnbounded && r.Intn(2) == 0, lowerUnbounded, !upperUnbounded && r.Intn(2) == 0, upperUnbounded)
+}
+
+// Generate makes Interval a quick.Generator, so quick.Check can pass random intervals to a property. The bounds are
+// taken from -size to size, clipped to the values of T.
+func (i *Interval[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	c := DefaultRandomConfig[T]()
+	c.Min, c.Max = randomRange[T](size)
+	return reflect.ValueOf(GenerateRandom(r, c))
+}
+
+// randomValue returns a random value from c.Min to c.Max, an integer for integer types.
+func randomValue[T constraints.Integer | constraints.Float](r *rand.Rand, c RandomConfig[T]) T {
+	v := float64(c.Min) + r.Float64()*(float64(c.Max)-float64(c.Min))
+	if discrete[T]() {
+		v = math.Round(v)
+	}
+	if v >= float64(c.Max) {
+		return c.Max
+	}
+	return max(T(v), c.Min)
+}
+
+// randomRange returns -size and size, or 0 for unsigned types, clipped to the values of T.
+func randomRange[T constraints.Integer | constraints.Float](size int) (T, T) {
+	limit := T(size)
+	if discrete[T]() {
+		if _, highest := extremes[T](); float64(size) >= float64(highest) {
+			limit = highest
+		}
+	}
+	if T(0)-1 > 0 {
+		return 0, limit
+	}
+	return -limit, limit
+}
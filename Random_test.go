@@ -0,0 +1,48 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerateRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var empty, point, unbounded int
+	for n := 0; n < 1000; n++ {
+		i := GenerateRandom[int](r)
+		if _, er := NewIntervalChecked(i.Lower(), i.Upper(), i.LowerIncluded(), i.LowerUnbounded(), i.UpperIncluded(), i.UpperUnbounded()); er != nil {
+			t.Fatalf("want a valid interval but get %s: %v", i, er)
+		}
+		switch {
+		case i.IsEmpty():
+			empty++
+		case i.IsPoint():
+			point++
+		case i.LowerUnbounded() || i.UpperUnbounded():
+			unbounded++
+		}
+	}
+	if empty == 0 || point == 0 || unbounded == 0 {
+		t.Errorf("want empty, point and unbounded intervals but get %d, %d and %d", empty, point, unbounded)
+	}
+	cfg := RandomConfig[uint8]{Min: 200, Max: 255}
+	for n := 0; n < 1000; n++ {
+		if i := GenerateRandom(r, cfg); i.Lower() < 200 || i.Upper() < 200 {
+			t.Fatalf("want bounds from 200 to 255 but get %s", i)
+		}
+	}
+}
+
+func TestIntervalGenerate(t *testing.T) {
+	intersectWithin := func(a, b *Interval[float64]) bool {
+		x := a.Intersect(b)
+		return x == nil || a.Contains(x) && b.Contains(x)
+	}
+	if er := quick.Check(intersectWithin, nil); er != nil {
+		t.Error(er)
+	}
+	if er := quick.Check(func(i *Interval[int8]) bool { return i != nil }, nil); er != nil {
+		t.Error(er)
+	}
+}
@@ -0,0 +1,155 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"strings"
+	"time"
+)
+
+// Anchor is a named reference value, like "now" or "D", which intervals can be written relative to, as in
+// "[now+5m0s .. now+20m0s)" or "[D+3 .. D+7]".
+type Anchor[T constraints.Integer | constraints.Float] struct {
+	Name  string
+	Value T
+	// FormatOffset writes a non-negative offset from Value, fmt's %v is used if it is nil.
+	FormatOffset func(offset T) string
+	// ParseOffset reads an offset written by FormatOffset, fmt.Sscan is used if it is nil.
+	ParseOffset func(s string) (T, error)
+}
+
+// TimeAnchor returns an anchor at t for time intervals, with offsets written as time.Duration.
+func TimeAnchor(name string, t time.Time) Anchor[int64] {
+	return Anchor[int64]{
+		Name:  name,
+		Value: t.UnixNano(),
+		FormatOffset: func(offset int64) string {
+			return time.Duration(offset).String()
+		},
+		ParseOffset: func(s string) (int64, error) {
+			d, er := time.ParseDuration(s)
+			return int64(d), er
+		},
+	}
+}
+
+// FormatRelative writes receiver interval with both bounds relative to anchor, like "[now+5m0s .. now+20m0s)".
+// An unbounded side is left empty, as in "(.. D+7]".
+func (i *Interval[T]) FormatRelative(anchor Anchor[T]) string {
+	i = i.orEmpty()
+	var b strings.Builder
+	if i.lower.Kind == ClosedBound {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if i.lower.Kind != Unbounded {
+		b.WriteString(anchor.format(i.lower.Value))
+		b.WriteByte(' ')
+	}
+	b.WriteString("..")
+	if i.upper.Kind != Unbounded {
+		b.WriteByte(' ')
+		b.WriteString(anchor.format(i.upper.Value))
+	}
+	if i.upper.Kind == ClosedBound {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// ParseRelative reads an interval written relative to anchor, as by FormatRelative. Without brackets the interval
+// is taken to be half-open, so "now+5m .. now+20m" is read as "[now+5m .. now+20m)".
+func ParseRelative[T constraints.Integer | constraints.Float](s string, anchor Anchor[T]) (*Interval[T], error) {
+	body := strings.TrimSpace(s)
+	lowerIncluded, upperIncluded := true, false
+	if strings.HasPrefix(body, "[") || strings.HasPrefix(body, "(") {
+		lowerIncluded = body[0] == '['
+		body = body[1:]
+	}
+	if strings.HasSuffix(body, "]") || strings.HasSuffix(body, ")") {
+		upperIncluded = body[len(body)-1] == ']'
+		body = body[:len(body)-1]
+	}
+	lowerPart, upperPart, found := strings.Cut(body, "..")
+	if !found {
+		return nil, fmt.Errorf("interval: relative interval %q has no '..' between its bounds", s)
+	}
+	interval := NewInterval[T](0, 0, lowerIncluded, false, upperIncluded, false)
+	if lowerPart = strings.TrimSpace(lowerPart); lowerPart == "" {
+		interval.SetLowerUnbounded(true)
+		interval.SetLowerIncluded(false)
+	} else {
+		lower, er := anchor.parse(lowerPart)
+		if er != nil {
+			return nil, fmt.Errorf("interval: lower bound of relative interval %q: %w", s, er)
+		}
+		interval.SetLower(lower)
+	}
+	if upperPart = strings.TrimSpace(upperPart); upperPart == "" {
+		interval.SetUpperUnbounded(true)
+		interval.SetUpperIncluded(false)
+	} else {
+		upper, er := anchor.parse(upperPart)
+		if er != nil {
+			return nil, fmt.Errorf("interval: upper bound of relative interval %q: %w", s, er)
+		}
+		interval.SetUpper(upper)
+	}
+	return interval, nil
+}
+
+// format writes v as the anchor name followed by the offset of v from the anchor value.
+func (a Anchor[T]) format(v T) string {
+	switch {
+	case v > a.Value:
+		return a.Name + "+" + a.formatOffset(v-a.Value)
+	case v < a.Value:
+		return a.Name + "-" + a.formatOffset(a.Value-v)
+	}
+	return a.Name
+}
+
+func (a Anchor[T]) formatOffset(offset T) string {
+	if a.FormatOffset != nil {
+		return a.FormatOffset(offset)
+	}
+	return fmt.Sprintf("%v", offset)
+}
+
+// parse reads a value written by format.
+func (a Anchor[T]) parse(s string) (T, error) {
+	rest, found := strings.CutPrefix(s, a.Name)
+	if !found {
+		return 0, fmt.Errorf("%q is not relative to %s", s, a.Name)
+	}
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return a.Value, nil
+	}
+	sign := rest[0]
+	if sign != '+' && sign != '-' {
+		return 0, fmt.Errorf("%q has no '+' or '-' after %s", s, a.Name)
+	}
+	offset, er := a.parseOffset(strings.TrimSpace(rest[1:]))
+	if er != nil {
+		return 0, er
+	}
+	if sign == '-' {
+		return a.Value - offset, nil
+	}
+	return a.Value + offset, nil
+}
+
+func (a Anchor[T]) parseOffset(s string) (T, error) {
+	if a.ParseOffset != nil {
+		return a.ParseOffset(s)
+	}
+	var offset T
+	if _, er := fmt.Sscan(s, &offset); er != nil {
+		return 0, er
+	}
+	return offset, nil
+}
@@ -0,0 +1,42 @@
+package interval
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelative(t *testing.T) {
+	day := Anchor[int]{Name: "D", Value: 100}
+	for _, tc := range []struct {
+		interval *Interval[int]
+		s        string
+	}{
+		{NewInterval(103, 107, true, false, true, false), "[D+3 .. D+7]"},
+		{NewInterval(97, 100, false, false, false, false), "(D-3 .. D)"},
+		{NewInterval(0, 107, false, true, true, false), "(.. D+7]"},
+		{NewInterval(103, 0, true, false, false, true), "[D+3 ..)"},
+	} {
+		if s := tc.interval.FormatRelative(day); s != tc.s {
+			t.Errorf("want %s.FormatRelative(D) = %s but get %s", tc.interval, tc.s, s)
+		}
+		i, er := ParseRelative(tc.s, day)
+		if er != nil || !i.Equal(tc.interval) {
+			t.Errorf("want ParseRelative(%s) = %s but get %v, %v", tc.s, tc.interval, i, er)
+		}
+	}
+
+	now := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	anchor := TimeAnchor("now", now)
+	i, er := ParseRelative("now+5m .. now+20m", anchor)
+	if er != nil || !i.Equal(NewTimeInterval(now.Add(5*time.Minute), now.Add(20*time.Minute))) {
+		t.Errorf("want ParseRelative(now+5m .. now+20m) to be half-open from 15:05 to 15:20 but get %v, %v", i, er)
+	}
+	if s := i.FormatRelative(anchor); s != "[now+5m0s .. now+20m0s)" {
+		t.Errorf("want FormatRelative to write [now+5m0s .. now+20m0s) but get %s", s)
+	}
+	for _, s := range []string{"[D+3, D+7]", "[X+3 .. D+7]", "[D*3 .. D+7]", "[D+x .. D+7]"} {
+		if _, er := ParseRelative(s, day); er == nil {
+			t.Errorf("want ParseRelative(%s) to fail", s)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// RolloutRing assigns the members whose percentage falls in Percentages to Cohort, like [0, 5) to "canary".
+type RolloutRing[T constraints.Integer | constraints.Float] struct {
+	Cohort      string
+	Percentages IInterval[T]
+}
+
+// Rollout classifies members of a progressive delivery into cohorts by percentage.
+type Rollout[T constraints.Integer | constraints.Float] struct {
+	rings []RolloutRing[T]
+}
+
+// NewRollout returns a Rollout over rings, which must together partition [0, 100) as checked by ValidatePartition.
+// For integer types the rings may be written closed, like [0, 4], [5, 49] and [50, 99].
+func NewRollout[T constraints.Integer | constraints.Float](rings ...RolloutRing[T]) (*Rollout[T], error) {
+	percentages := make([]IInterval[T], len(rings))
+	for n, r := range rings {
+		percentages[n] = r.Percentages
+	}
+	if er := ValidatePartition(percentages, IInterval[T](NewInterval[T](0, 100, true, false, false, false))); er != nil {
+		return nil, er
+	}
+	rollout := new(Rollout[T])
+	rollout.rings = append(rollout.rings, rings...)
+	return rollout, nil
+}
+
+// Rings returns the rings of the rollout in the order they were given.
+func (r *Rollout[T]) Rings() []RolloutRing[T] {
+	return append([]RolloutRing[T](nil), r.rings...)
+}
+
+// Cohort returns the cohort of the ring which has percentage, or false if percentage is outside [0, 100).
+func (r *Rollout[T]) Cohort(percentage T) (string, bool) {
+	for _, ring := range r.rings {
+		if ring.Percentages.Has(percentage) {
+			return ring.Cohort, true
+		}
+	}
+	return "", false
+}
+
+// CohortOfHash returns the cohort of a member by the hash of its identity, which is reduced to hash % 100.
+func (r *Rollout[T]) CohortOfHash(hash uint64) string {
+	cohort, _ := r.Cohort(T(hash % 100))
+	return cohort
+}
@@ -0,0 +1,50 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRollout(t *testing.T) {
+	r, er := NewRollout[int](
+		RolloutRing[int]{Cohort: "beta", Percentages: NewInterval(5, 50, true, false, false, false)},
+		RolloutRing[int]{Cohort: "canary", Percentages: NewInterval(0, 5, true, false, false, false)},
+		RolloutRing[int]{Cohort: "stable", Percentages: NewInterval(50, 100, true, false, false, false)},
+	)
+	if er != nil {
+		t.Fatalf("want NewRollout to accept a partition of [0, 100) but get %v", er)
+	}
+	for _, tc := range []struct {
+		hash   uint64
+		cohort string
+	}{
+		{0, "canary"}, {4, "canary"}, {5, "beta"}, {49, "beta"}, {50, "stable"}, {99, "stable"}, {1234, "beta"},
+	} {
+		if c := r.CohortOfHash(tc.hash); c != tc.cohort {
+			t.Errorf("want CohortOfHash(%d) = %s but get %s", tc.hash, tc.cohort, c)
+		}
+	}
+	if _, ok := r.Cohort(100); ok {
+		t.Errorf("want Cohort(100) to be outside the rollout")
+	}
+
+	r, er = NewRollout[int](
+		RolloutRing[int]{Cohort: "canary", Percentages: Closed(0, 4)},
+		RolloutRing[int]{Cohort: "beta", Percentages: Closed(5, 49)},
+		RolloutRing[int]{Cohort: "stable", Percentages: Closed(50, 99)},
+	)
+	if er != nil {
+		t.Fatalf("want NewRollout to accept the integer rings [0, 4], [5, 49] and [50, 99] but get %v", er)
+	}
+	if c, _ := r.Cohort(49); c != "beta" {
+		t.Errorf("want Cohort(49) = beta but get %s", c)
+	}
+
+	_, er = NewRollout[float64](
+		RolloutRing[float64]{Cohort: "canary", Percentages: NewInterval(0.0, 5, true, false, true, false)},
+		RolloutRing[float64]{Cohort: "stable", Percentages: NewInterval(5.0, 100, true, false, false, false)},
+	)
+	if !errors.Is(er, ErrPartitionOverlap) {
+		t.Errorf("want NewRollout to report %v but get %v", ErrPartitionOverlap, er)
+	}
+}
@@ -0,0 +1,109 @@
+package interval
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrScan is returned by Scan for a source value which is not text.
+var ErrScan = errors.New("interval: cannot scan value")
+
+// Value implements driver.Valuer, writing the interval as a PostgreSQL range literal like [3,7), with an unbounded
+// side left empty as in (,7], and "empty" for the empty interval. It has a value receiver, so that intervals in
+// structs are written also when they are not addressable.
+func (i Interval[T]) Value() (driver.Value, error) {
+	return i.rangeLiteral(), nil
+}
+
+// rangeLiteral returns receiver interval as a PostgreSQL range literal.
+func (i *Interval[T]) rangeLiteral() string {
+	if i.IsEmpty() {
+		return "empty"
+	}
+	var b strings.Builder
+	if i.lower.Kind == ClosedBound {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if i.lower.Kind != Unbounded {
+		b.WriteString(formatNumber(i.lower.Value, FormatOptions{}))
+	}
+	b.WriteByte(',')
+	if i.upper.Kind != Unbounded {
+		b.WriteString(formatNumber(i.upper.Value, FormatOptions{}))
+	}
+	if i.upper.Kind == ClosedBound {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// Scan implements sql.Scanner, reading a PostgreSQL range literal as written for int4range, int8range and numrange
+// columns, whose bounds may be quoted. NULL is read as the empty interval.
+func (i *Interval[T]) Scan(src any) error {
+	if src == nil {
+		*i = Interval[T]{}
+		return nil
+	}
+	s, er := scanText(src)
+	if er != nil {
+		return er
+	}
+	x, er := Parse[T](s)
+	if er != nil {
+		return er
+	}
+	*i = *x
+	return nil
+}
+
+// Value implements driver.Valuer, writing the set as a PostgreSQL multirange literal like {[1,3),[5,9)}, for
+// int4multirange, int8multirange and nummultirange columns. It has a value receiver, so that sets in structs are
+// written also when they are not addressable.
+func (s IntervalSet[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, x := range s.intervals {
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(copyOf(x).rangeLiteral())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner, reading a PostgreSQL multirange literal, whose bounds may be quoted, into the set.
+// NULL is read as the empty set. The journal of the set, if any, does not record it.
+func (s *IntervalSet[T]) Scan(src any) error {
+	if src == nil {
+		s.intervals = nil
+		return nil
+	}
+	text, er := scanText(src)
+	if er != nil {
+		return er
+	}
+	set, er := ParseSet[T](text)
+	if er != nil {
+		return er
+	}
+	s.intervals = set.intervals
+	return nil
+}
+
+// scanText returns the text of a value read from a database, without the quotes PostgreSQL may put around bounds.
+func scanText(src any) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return strings.ReplaceAll(v, `"`, ""), nil
+	case []byte:
+		return strings.ReplaceAll(string(v), `"`, ""), nil
+	}
+	return "", fmt.Errorf("%w: %T", ErrScan, src)
+}
@@ -0,0 +1,80 @@
+package interval
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Interval[int]{}
+	_ sql.Scanner   = new(Interval[int])
+)
+
+func TestIntervalSQL(t *testing.T) {
+	for _, tc := range []struct {
+		i       *Interval[float64]
+		literal string
+	}{
+		{ClosedOpen(3.0, 7), "[3,7)"},
+		{OpenClosed(-1.5, 2.25), "(-1.5,2.25]"},
+		{AtMost(7.0), "(,7]"},
+		{AtLeast(3.0), "[3,)"},
+		{All[float64](), "(,)"},
+		{Empty[float64](), "empty"},
+	} {
+		v, er := tc.i.Value()
+		if er != nil || v != tc.literal {
+			t.Errorf("want Value() of %s = %s but get %v, %v", tc.i, tc.literal, v, er)
+		}
+		var x Interval[float64]
+		if er := x.Scan([]byte(tc.literal)); er != nil || !x.Equal(tc.i) {
+			t.Errorf("want Scan(%s) = %s but get %s, %v", tc.literal, tc.i, &x, er)
+		}
+	}
+	var x Interval[int64]
+	if er := x.Scan(`["3","7")`); er != nil || !x.Equal(ClosedOpen[int64](3, 7)) {
+		t.Errorf("want Scan of quoted bounds = [3,7) but get %s, %v", &x, er)
+	}
+	if er := x.Scan(nil); er != nil || !x.IsEmpty() {
+		t.Errorf("want Scan(nil) to be empty but get %s, %v", &x, er)
+	}
+	if er := x.Scan(int64(3)); !errors.Is(er, ErrScan) {
+		t.Errorf("want Scan of an int64 to fail with ErrScan but get %v", er)
+	}
+	if er := x.Scan("[3,7"); !errors.Is(er, ErrSyntax) {
+		t.Errorf("want Scan of a bad literal to fail with ErrSyntax but get %v", er)
+	}
+}
+
+func TestIntervalSetSQL(t *testing.T) {
+	var s IntervalSet[int64]
+	s.Add(ClosedOpen[int64](5, 9))
+	s.Add(ClosedOpen[int64](1, 3))
+	s.Add(AtLeast[int64](20))
+	v, er := s.Value()
+	if er != nil || v != "{[1,3),[5,9),[20,)}" {
+		t.Errorf("want Value() of %s = {[1,3),[5,9),[20,)} but get %v, %v", &s, v, er)
+	}
+	var r IntervalSet[int64]
+	if er := r.Scan([]byte(v.(string))); er != nil || r.String() != s.String() {
+		t.Errorf("want Scan(%s) = %s but get %s, %v", v, &s, &r, er)
+	}
+	if er := r.Scan(`{["1","3"), empty, [2,4]}`); er != nil || r.String() != "{[1,4]}" {
+		t.Errorf("want Scan of quoted and empty ranges = {[1,4]} but get %s, %v", &r, er)
+	}
+	var e IntervalSet[int64]
+	if v, er := e.Value(); er != nil || v != "{}" {
+		t.Errorf("want Value() of the empty set = {} but get %v, %v", v, er)
+	}
+	if er := r.Scan(nil); er != nil || !r.IsEmpty() {
+		t.Errorf("want Scan(nil) to be the empty set but get %s, %v", &r, er)
+	}
+	if er := r.Scan("[1,3)"); !errors.Is(er, ErrSyntax) {
+		t.Errorf("want Scan of a range to fail with ErrSyntax but get %v", er)
+	}
+	if er := r.Scan(3.5); !errors.Is(er, ErrScan) {
+		t.Errorf("want Scan of a float64 to fail with ErrScan but get %v", er)
+	}
+}
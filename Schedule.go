@@ -0,0 +1,64 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+	"sort"
+)
+
+// Job is a piece of work which needs Duration of processing time, to be done within Window.
+type Job[T constraints.Integer | constraints.Float] struct {
+	Duration T
+	Window   IInterval[T]
+}
+
+// CheckEDF tests whether jobs can all be completed within their windows on a single preemptive processor, which is
+// exactly when earliest-deadline-first scheduling meets every deadline. It returns nil if the jobs are feasible,
+// otherwise the first window, ordered by deadline, in which the jobs which must run entirely inside it need more
+// time than the window is long. A window offers Upper() - Lower() of time, the inclusion of its bounds does not
+// change that. Jobs with an unbounded window side never constrain the schedule; a job which needs time but has an
+// empty window is reported with that window.
+func CheckEDF[T constraints.Integer | constraints.Float](jobs []Job[T]) IInterval[T] {
+	var bounded []Job[T]
+	for _, j := range jobs {
+		if j.Duration <= 0 {
+			continue
+		}
+		if j.Window == nil {
+			return new(Interval[T])
+		}
+		if j.Window.IsEmpty() {
+			return j.Window
+		}
+		if !j.Window.LowerUnbounded() && !j.Window.UpperUnbounded() {
+			bounded = append(bounded, j)
+		}
+	}
+	sort.SliceStable(bounded, func(p, q int) bool {
+		return bounded[p].Window.Lower() > bounded[q].Window.Lower()
+	})
+	deadlines := make([]IInterval[T], len(bounded))
+	for n, j := range bounded {
+		deadlines[n] = j.Window
+	}
+	sort.SliceStable(deadlines, func(p, q int) bool {
+		return deadlines[p].Upper() < deadlines[q].Upper()
+	})
+	for n, d := range deadlines {
+		if n > 0 && deadlines[n-1].Upper() == d.Upper() {
+			continue
+		}
+		var demand T
+		for m, j := range bounded {
+			if j.Window.Upper() <= d.Upper() {
+				demand += j.Duration
+			}
+			if m+1 < len(bounded) && bounded[m+1].Window.Lower() == j.Window.Lower() {
+				continue
+			}
+			if j.Window.Lower() <= d.Upper() && demand > d.Upper()-j.Window.Lower() {
+				return NewInterval[T](j.Window.Lower(), d.Upper(), j.Window.LowerIncluded(), false, d.UpperIncluded(), false)
+			}
+		}
+	}
+	return nil
+}
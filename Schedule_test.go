@@ -0,0 +1,81 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"testing"
+)
+
+func TestCheckEDF(t *testing.T) {
+	testCheckEDF[int](t)
+	testCheckEDF[float64](t)
+}
+
+func testCheckEDF[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsCheckEDF {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var jobs []Job[T]
+			for k, s := range tc.windows {
+				w, er := parseInterval[T](s)
+				if er != nil {
+					t.Errorf(er.Error())
+					return
+				}
+				jobs = append(jobs, Job[T]{Duration: T(tc.durations[k]), Window: w})
+			}
+			v := CheckEDF(jobs)
+			wv, er := parseInterval[T](tc.violated)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			if wv == nil {
+				if v != nil {
+					t.Errorf("want CheckEDF(%v) = nil but is %s, counter: %v", tc.windows, v, tc.counter)
+				}
+				return
+			}
+			if v == nil || !v.Equal(wv) {
+				t.Errorf("want CheckEDF(%v) = %s but is %v, counter: %v", tc.windows, wv, v, tc.counter)
+			}
+		})
+	}
+}
+
+var testsCheckEDF = []struct {
+	windows   []string
+	durations []int
+	violated  string
+	counter   string
+}{
+	{
+		windows:   []string{"  |====|  ", "  |--========|  "},
+		durations: []int{4, 6},
+		violated:  "",
+		counter:   "0",
+	},
+	{
+		windows:   []string{"  |====|  ", "  |--========|  "},
+		durations: []int{4, 7},
+		violated:  "  |==========|  ",
+		counter:   "1",
+	},
+	{
+		windows:   []string{"  |========|  ", "  |--===|  ", "  |---===|  "},
+		durations: []int{2, 3, 2},
+		violated:  "  |--====|  ",
+		counter:   "2",
+	},
+	{
+		windows:   []string{" <|====|  ", "  |--========|> "},
+		durations: []int{40, 70},
+		violated:  "",
+		counter:   "3",
+	},
+	{
+		windows:   []string{"  |====|  ", " *|--&|* "},
+		durations: []int{1, 1},
+		violated:  " *|--&|* ",
+		counter:   "4",
+	},
+}
@@ -0,0 +1,49 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaVersion is the version of the serialized forms written by this package. Every form carries it, so that data
+// persisted by an older version is still read, and migrated, after the forms change.
+//
+// Version 0 is data written before forms were versioned, which is read as version 1. Version 2 writes intervals in
+// mathematical notation, like [0,5) and (-∞,3], where versions 0 and 1 wrote [0, 5) and <(0, 3].
+const SchemaVersion = 2
+
+// ErrSchemaVersion is returned when serialized data has a version this package does not know.
+var ErrSchemaVersion = errors.New("interval: unsupported schema version")
+
+// versionText prefixes text written by this package with the schema version, as in "v2 {[0,5)}".
+func versionText(body string) string {
+	return "v" + strconv.Itoa(SchemaVersion) + " " + body
+}
+
+// splitVersionText returns the schema version and the body of text written by versionText. Text without a version
+// prefix is returned as version 0.
+func splitVersionText(s string) (version int, body string, err error) {
+	s = strings.TrimSpace(s)
+	prefix, rest, found := strings.Cut(s, " ")
+	if !found || !strings.HasPrefix(prefix, "v") {
+		return 0, s, nil
+	}
+	version, err = strconv.Atoi(prefix[1:])
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %q", ErrSchemaVersion, prefix)
+	}
+	if err = CheckSchemaVersion(version); err != nil {
+		return 0, "", err
+	}
+	return version, rest, nil
+}
+
+// CheckSchemaVersion returns ErrSchemaVersion if data of version cannot be read by this package.
+func CheckSchemaVersion(version int) error {
+	if version < 0 || version > SchemaVersion {
+		return fmt.Errorf("%w: %d, want at most %d", ErrSchemaVersion, version, SchemaVersion)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSplitVersionText(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		version int
+		body    string
+		err     error
+	}{
+		{versionText("{[0, 5)}"), SchemaVersion, "{[0, 5)}", nil},
+		{" v1 {[0, 5)} ", 1, "{[0, 5)}", nil},
+		{"{[0, 5)}", 0, "{[0, 5)}", nil},
+		{"v3 {[0, 5)}", 0, "", ErrSchemaVersion},
+		{"vx {[0, 5)}", 0, "", ErrSchemaVersion},
+		{"v-1 {[0, 5)}", 0, "", ErrSchemaVersion},
+	} {
+		version, body, er := splitVersionText(tc.s)
+		if version != tc.version || body != tc.body || !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) {
+			t.Errorf("want splitVersionText(%q) = %d, %q, %v but is %d, %q, %v", tc.s, tc.version, tc.body, tc.err, version, body, er)
+		}
+	}
+}
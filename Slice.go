@@ -0,0 +1,34 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptySliceRange is returned by ToSliceRange when no index of the slice lies within the interval.
+var ErrEmptySliceRange = errors.New("interval: no slice index within interval")
+
+// ToSliceRange returns the indexes lo and hi such that s[lo:hi] holds exactly the elements of a slice of length
+// whose index lies within i. Unbounded sides extend to the begin or end of the slice.
+func ToSliceRange(i IInterval[int], length int) (lo, hi int, err error) {
+	if i == nil || i.IsEmpty() {
+		return 0, 0, fmt.Errorf("%w: interval %v is empty", ErrEmptySliceRange, i)
+	}
+	lo, hi = 0, length
+	if !i.LowerUnbounded() {
+		lo = max(lo, i.Lower())
+		if !i.LowerIncluded() && i.Lower() >= 0 && i.Lower() < length {
+			lo = max(lo, i.Lower()+1)
+		}
+	}
+	if !i.UpperUnbounded() {
+		hi = min(hi, i.Upper())
+		if i.UpperIncluded() && i.Upper() < length {
+			hi = min(length, i.Upper()+1)
+		}
+	}
+	if lo >= hi {
+		return 0, 0, fmt.Errorf("%w: interval %s, length %d", ErrEmptySliceRange, i, length)
+	}
+	return lo, hi, nil
+}
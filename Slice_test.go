@@ -0,0 +1,34 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToSliceRange(t *testing.T) {
+	for _, tc := range []struct {
+		s      string
+		length int
+		lo, hi int
+		err    error
+	}{
+		{"  |----====|  ", 10, 4, 9, nil},
+		{" *|----====|* ", 10, 5, 8, nil},
+		{" <|----====|* ", 10, 0, 8, nil},
+		{"  |----====|> ", 6, 4, 6, nil},
+		{"  |----====|  ", 8, 4, 8, nil},
+		{"  |----====|  ", 4, 0, 0, ErrEmptySliceRange},
+		{" *|----&|* ", 10, 0, 0, ErrEmptySliceRange},
+		{" *|----=|* ", 10, 0, 0, ErrEmptySliceRange},
+	} {
+		i, er := parseInterval[int](tc.s)
+		if er != nil {
+			t.Errorf(er.Error())
+			continue
+		}
+		lo, hi, er := ToSliceRange(i, tc.length)
+		if lo != tc.lo || hi != tc.hi || !errors.Is(er, tc.err) || (er == nil) != (tc.err == nil) {
+			t.Errorf("want ToSliceRange(%s, %d) = %d, %d, %v but get %d, %d, %v", i, tc.length, tc.lo, tc.hi, tc.err, lo, hi, er)
+		}
+	}
+}
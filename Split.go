@@ -0,0 +1,94 @@
+package interval
+
+import (
+	"iter"
+	"slices"
+)
+
+// CutSide tells which of the two pieces of a split gets the cut point.
+type CutSide int
+
+const (
+	// CutToUpper makes the cut point the included lower bound of the upper piece: [a, p) and [p, b].
+	CutToUpper CutSide = iota
+	// CutToLower makes the cut point the included upper bound of the lower piece: [a, p] and (p, b].
+	CutToLower
+)
+
+// SplitAt returns the pieces of receiver interval below and above point, with point in the piece given by side.
+// If point is not strictly between the bounds of receiver interval, receiver interval is returned as the first
+// piece and the second is nil.
+func (i *Interval[T]) SplitAt(point T, side CutSide) (IInterval[T], IInterval[T]) {
+	i = i.orEmpty()
+	if i.IsEmpty() {
+		return nil, nil
+	}
+	if !i.cuts(point) {
+		return i, nil
+	}
+	lower := NewInterval[T](i.lower.Value, point, i.lower.Kind == ClosedBound, i.lower.Kind == Unbounded, side == CutToLower, false)
+	upper := NewInterval[T](point, i.upper.Value, side == CutToUpper, false, i.upper.Kind == ClosedBound, i.upper.Kind == Unbounded)
+	return lower, upper
+}
+
+// SplitAtAll returns the consecutive pieces of receiver interval when split at each of points, ascending, with each
+// point in the piece given by side. Points which are not strictly between the bounds are ignored.
+func (i *Interval[T]) SplitAtAll(points []T, side CutSide) []IInterval[T] {
+	if i.IsEmpty() {
+		return nil
+	}
+	cuts := slices.Clone(points)
+	slices.Sort(cuts)
+	cuts = slices.Compact(cuts)
+	var pieces []IInterval[T]
+	var rest IInterval[T] = i
+	for _, p := range cuts {
+		if !i.cuts(p) {
+			continue
+		}
+		var piece IInterval[T]
+		piece, rest = rest.SplitAt(p, side)
+		pieces = append(pieces, piece)
+	}
+	return append(pieces, rest)
+}
+
+// Partition returns the consecutive pieces of receiver interval when cut at each of points, ascending, as buckets of
+// a histogram: each point is the included lower bound of the piece above it. It is SplitAtAll with CutToUpper.
+func (i *Interval[T]) Partition(points ...T) []IInterval[T] {
+	return i.SplitAtAll(points, CutToUpper)
+}
+
+// Chunks returns consecutive pieces of receiver interval, ascending, each no longer than maxChunk or for integer types
+// holding at most maxChunk values, which together cover exactly receiver interval: every value is in exactly one chunk. Every chunk but the last is half-open, the
+// first chunk has the lower bound and the last chunk the upper bound of receiver interval. Nothing is yielded for an
+// empty or unbounded interval or a maxChunk which is not positive.
+func (i *Interval[T]) Chunks(maxChunk T) iter.Seq[IInterval[T]] {
+	i = i.orEmpty()
+	return func(yield func(IInterval[T]) bool) {
+		if i.IsEmpty() || i.lower.Kind == Unbounded || i.upper.Kind == Unbounded || maxChunk <= 0 {
+			return
+		}
+		lower, lowerIncluded := i.lower.Value, i.lower.Kind == ClosedBound
+		end := i.upper.Value
+		if discrete[T]() {
+			end = closed[T](i).upper.Value
+		}
+		for {
+			next, overflow := add(lower, maxChunk)
+			if overflow != 0 || next > end || next == end && !discrete[T]() {
+				break
+			}
+			if !yield(NewInterval[T](lower, next, lowerIncluded, false, false, false)) {
+				return
+			}
+			lower, lowerIncluded = next, true
+		}
+		yield(NewInterval[T](lower, i.upper.Value, lowerIncluded, false, i.upper.Kind == ClosedBound, false))
+	}
+}
+
+// cuts returns true if point lies strictly between the bounds of receiver interval.
+func (i *Interval[T]) cuts(point T) bool {
+	return (i.lower.Kind == Unbounded || i.lower.Value < point) && (i.upper.Kind == Unbounded || point < i.upper.Value)
+}
@@ -0,0 +1,210 @@
+package interval
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"slices"
+	"testing"
+)
+
+func TestIntervalSplitAtAll(t *testing.T) {
+	testIntervalSplitAtAll[int](t)
+	testIntervalSplitAtAll[float64](t)
+}
+
+func testIntervalSplitAtAll[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalSplitAtAll {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			points := make([]T, len(tc.points))
+			for k, p := range tc.points {
+				points[k] = T(p)
+			}
+			pieces := i.SplitAtAll(points, tc.side)
+			if !equalIntervals(t, pieces, tc.pieces) {
+				t.Errorf("want %s.SplitAtAll(%v, %v) = %v but is %v, counter: %v", i, tc.points, tc.side, tc.pieces, pieces, tc.counter)
+			}
+		})
+	}
+}
+
+var testsIntervalSplitAtAll = []struct {
+	i_interval_string string
+	points            []int
+	side              CutSide
+	pieces            []string
+	counter           string
+}{
+	{
+		i_interval_string: "  |============|  ",
+		points:            []int{8, 4},
+		side:              CutToUpper,
+		pieces:            []string{"  |====|*", "  |----====|*", "  |--------====|  "},
+		counter:           "0",
+	},
+	{
+		i_interval_string: "  |============|  ",
+		points:            []int{4, 8, 4},
+		side:              CutToLower,
+		pieces:            []string{"  |====|  ", " *|----====|  ", " *|--------====|  "},
+		counter:           "1",
+	},
+	{
+		i_interval_string: " *|----========|* ",
+		points:            []int{0, 4, 12, 20},
+		side:              CutToUpper,
+		pieces:            []string{" *|----========|* "},
+		counter:           "2",
+	},
+	{
+		i_interval_string: " <|============|> ",
+		points:            []int{5, 20},
+		side:              CutToUpper,
+		pieces:            []string{" <|=====|* ", "  |-----===============|* ", "  |--------------------=|> "},
+		counter:           "3",
+	},
+	{
+		i_interval_string: " *|----&|* ",
+		points:            []int{4},
+		side:              CutToUpper,
+		pieces:            nil,
+		counter:           "4",
+	},
+}
+
+func TestIntervalPartition(t *testing.T) {
+	testIntervalPartition[int](t)
+	testIntervalPartition[float64](t)
+}
+
+func testIntervalPartition[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalPartition {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			points := make([]T, len(tc.points))
+			for k, p := range tc.points {
+				points[k] = T(p)
+			}
+			pieces := i.Partition(points...)
+			if !equalIntervals(t, pieces, tc.pieces) {
+				t.Errorf("want %s.Partition(%v) = %v but is %v, counter: %v", i, tc.points, tc.pieces, pieces, tc.counter)
+			}
+		})
+	}
+}
+
+var testsIntervalPartition = []struct {
+	i_interval_string string
+	points            []int
+	pieces            []string
+	counter           string
+}{
+	{
+		i_interval_string: "  |============|* ",
+		points:            []int{4, 8},
+		pieces:            []string{"  |====|*", "  |----====|*", "  |--------====|* "},
+		counter:           "0",
+	},
+	{
+		i_interval_string: "  |============|  ",
+		points:            nil,
+		pieces:            []string{"  |============|  "},
+		counter:           "1",
+	},
+	{
+		i_interval_string: " <|============|> ",
+		points:            []int{12, 4, 12},
+		pieces:            []string{" <|====|* ", "  |----========|* ", "  |------------=|> "},
+		counter:           "2",
+	},
+}
+
+func TestIntervalChunks(t *testing.T) {
+	testIntervalChunks[int](t)
+	testIntervalChunks[float64](t)
+}
+
+func testIntervalChunks[T constraints.Integer | constraints.Float](t *testing.T) {
+	for n, tc := range testsIntervalChunks {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, er := parseInterval[T](tc.i_interval_string)
+			if er != nil {
+				t.Errorf(er.Error())
+				return
+			}
+			var chunks []IInterval[T]
+			for c := range i.Chunks(T(tc.maxChunk)) {
+				chunks = append(chunks, c)
+			}
+			if !equalIntervals(t, chunks, tc.chunks) {
+				t.Errorf("want %s.Chunks(%v) = %v but is %v, counter: %v", i, tc.maxChunk, tc.chunks, chunks, tc.counter)
+			}
+		})
+	}
+}
+
+func TestIntervalChunksIntegers(t *testing.T) {
+	for _, i := range []*Interval[int]{Closed(0, 10), ClosedOpen(0, 10), Open(0, 10), OpenClosed(-7, 13), Point(5)} {
+		for maxChunk := 1; maxChunk <= 12; maxChunk++ {
+			var values float64
+			for c := range i.Chunks(maxChunk) {
+				if n := size(c); n > float64(maxChunk) {
+					t.Errorf("want chunks of %s.Chunks(%d) to hold at most %d values but %s holds %v", i, maxChunk, maxChunk, c, n)
+				}
+				values += size(c)
+			}
+			if values != size[int](i) {
+				t.Errorf("want the chunks of %s.Chunks(%d) to hold its %v values but they hold %v", i, maxChunk, size[int](i), values)
+			}
+		}
+	}
+	if chunks := slices.Collect(Closed(0, 10).Chunks(10)); len(chunks) != 2 || !chunks[0].Equal(ClosedOpen(0, 10)) || !chunks[1].Equal(Point(10)) {
+		t.Errorf("want [0,10].Chunks(10) = [[0,10) [10,10]] but get %v", chunks)
+	}
+}
+
+var testsIntervalChunks = []struct {
+	i_interval_string string
+	maxChunk          int
+	chunks            []string
+	counter           string
+}{
+	{
+		i_interval_string: " *|==========|  ",
+		maxChunk:          4,
+		chunks:            []string{" *|====|* ", "  |----====|* ", "  |--------==|  "},
+		counter:           "0",
+	},
+	{
+		i_interval_string: "  |========|* ",
+		maxChunk:          4,
+		chunks:            []string{"  |====|* ", "  |----====|* "},
+		counter:           "1",
+	},
+	{
+		i_interval_string: "  |---&|  ",
+		maxChunk:          4,
+		chunks:            []string{"  |---&|  "},
+		counter:           "2",
+	},
+	{
+		i_interval_string: "  |========|> ",
+		maxChunk:          4,
+		chunks:            nil,
+		counter:           "3",
+	},
+	{
+		i_interval_string: "  |========|  ",
+		maxChunk:          0,
+		chunks:            nil,
+		counter:           "4",
+	},
+}
@@ -0,0 +1,50 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// TemporalMap maps key ranges to values which are valid during a time range, like the history of a feature flag or
+// effective-dated configuration. Times are nanoseconds since the Unix epoch, as in NewTimeInterval.
+type TemporalMap[K constraints.Integer | constraints.Float, V any] struct {
+	keys IntervalMap[K, *IntervalMap[int64, V]]
+}
+
+func NewTemporalMap[K constraints.Integer | constraints.Float, V any]() *TemporalMap[K, V] {
+	return new(TemporalMap[K, V])
+}
+
+// Set maps the keys to value during validity, replacing what those keys were mapped to during validity before.
+func (m *TemporalMap[K, V]) Set(keys IInterval[K], validity IInterval[int64], value V) {
+	if validity == nil || validity.IsEmpty() {
+		return
+	}
+	m.keys.Apply(keys, func(history *IntervalMap[int64, V], ok bool) *IntervalMap[int64, V] {
+		if ok {
+			history = history.Clone()
+		} else {
+			history = NewIntervalMap[int64, V]()
+		}
+		history.Set(validity, value)
+		return history
+	})
+}
+
+// Get returns what key was mapped to at time t, or false if it was not mapped then.
+func (m *TemporalMap[K, V]) Get(key K, t int64) (V, bool) {
+	history, ok := m.keys.Get(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return history.Get(t)
+}
+
+// History returns the values key was mapped to with the time ranges they were valid, ascending.
+func (m *TemporalMap[K, V]) History(key K) []MapEntry[int64, V] {
+	history, ok := m.keys.Get(key)
+	if !ok {
+		return nil
+	}
+	return history.Entries()
+}
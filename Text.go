@@ -0,0 +1,22 @@
+package interval
+
+// MarshalText implements encoding.TextMarshaler, writing the interval as String does with its schema version, like
+// "v2 [1,5)". It has a value receiver, so that intervals can be keys of maps written by encoding/json.
+func (i Interval[T]) MarshalText() ([]byte, error) {
+	return []byte(versionText(i.String())), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, reading the interval as Parse does. Text without a schema
+// version, as written before text was versioned, is read too.
+func (i *Interval[T]) UnmarshalText(text []byte) error {
+	_, body, er := splitVersionText(string(text))
+	if er != nil {
+		return er
+	}
+	x, er := Parse[T](body)
+	if er != nil {
+		return er
+	}
+	*i = *x
+	return nil
+}
@@ -0,0 +1,40 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIntervalText(t *testing.T) {
+	for _, x := range []*Interval[float64]{ClosedOpen(1.0, 5), AtMost(-2.5), All[float64](), Empty[float64]()} {
+		text, er := x.MarshalText()
+		if er != nil || string(text) != versionText(x.String()) {
+			t.Errorf("want MarshalText of %s to be its versioned String but get %s, %v", x, text, er)
+		}
+		var y Interval[float64]
+		if er := y.UnmarshalText(text); er != nil || !y.Equal(x) {
+			t.Errorf("want UnmarshalText(%s) = %s but get %s, %v", text, x, &y, er)
+		}
+	}
+	var y Interval[int]
+	if er := y.UnmarshalText([]byte("[1,5)")); er != nil || !y.Equal(ClosedOpen(1, 5)) {
+		t.Errorf("want UnmarshalText of text without a version to read [1,5) but get %s, %v", &y, er)
+	}
+	if er := y.UnmarshalText([]byte("[1,5")); !errors.Is(er, ErrSyntax) {
+		t.Errorf("want UnmarshalText of bad text to fail with ErrSyntax but get %v", er)
+	}
+	if er := y.UnmarshalText([]byte("v99 [1,5)")); !errors.Is(er, ErrSchemaVersion) {
+		t.Errorf("want UnmarshalText of an unknown version to fail with ErrSchemaVersion but get %v", er)
+	}
+
+	owners := map[Interval[int]]string{*ClosedOpen(0, 10): "a", *AtLeast(10): "b"}
+	b, er := json.Marshal(owners)
+	if er != nil || string(b) != `{"v2 [0,10)":"a","v2 [10,+∞)":"b"}` {
+		t.Errorf("want intervals as JSON map keys but get %s, %v", b, er)
+	}
+	var read map[Interval[int]]string
+	if er := json.Unmarshal(b, &read); er != nil || len(read) != 2 || read[*AtLeast(10)] != "b" {
+		t.Errorf("want JSON map keys to be read back but get %v, %v", read, er)
+	}
+}
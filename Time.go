@@ -0,0 +1,90 @@
+package interval
+
+import (
+	"iter"
+	"time"
+)
+
+// CalendarUnit is the length of a calendar period used by CalendarPeriod.
+type CalendarUnit int
+
+const (
+	Day CalendarUnit = iota
+	// Week starts on Monday, as in ISO 8601.
+	Week
+	Month
+	Year
+)
+
+// NewTimeInterval returns the half-open time interval [start, end). Time intervals are Interval[int64] of nanoseconds
+// since the Unix epoch, as returned by time.Time.UnixNano.
+func NewTimeInterval(start, end time.Time) *Interval[int64] {
+	return NewInterval[int64](start.UnixNano(), end.UnixNano(), true, false, false, false)
+}
+
+// Last returns the window of length d which ends at now, [now-d, now), like "the last 15 minutes".
+func Last(now time.Time, d time.Duration) *Interval[int64] {
+	return NewTimeInterval(now.Add(-d), now)
+}
+
+// CalendarPeriod returns the calendar period of unit which contains now, moved offset periods: 0 is the current
+// period, -1 the previous one, like "previous calendar month". The period is [start, end) where start and end are
+// midnight in the location of now, so a day across a daylight saving change is 23 or 25 hours long.
+func CalendarPeriod(now time.Time, unit CalendarUnit, offset int) *Interval[int64] {
+	y, m, d := now.Date()
+	loc := now.Location()
+	var start, end time.Time
+	switch unit {
+	case Week:
+		d -= (int(now.Weekday()) + 6) % 7
+		start = time.Date(y, m, d+7*offset, 0, 0, 0, 0, loc)
+		end = time.Date(y, m, d+7*offset+7, 0, 0, 0, 0, loc)
+	case Month:
+		start = time.Date(y, m+time.Month(offset), 1, 0, 0, 0, 0, loc)
+		end = time.Date(y, m+time.Month(offset)+1, 1, 0, 0, 0, 0, loc)
+	case Year:
+		start = time.Date(y+offset, time.January, 1, 0, 0, 0, 0, loc)
+		end = time.Date(y+offset+1, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		start = time.Date(y, m, d+offset, 0, 0, 0, 0, loc)
+		end = time.Date(y, m, d+offset+1, 0, 0, 0, 0, loc)
+	}
+	return NewTimeInterval(start, end)
+}
+
+// IsStale returns true if the data time lies before the window of length maxAge which ends at now.
+func IsStale(now, data time.Time, maxAge time.Duration) bool {
+	return data.UnixNano() < Last(now, maxAge).Lower()
+}
+
+// AlignedWindows returns the windows of length resolution which cover query, aligned to multiples of resolution
+// since the Unix epoch as time-series databases align their buckets. Every window is [start, start+resolution),
+// except that the first and last window are clipped to query; a window beyond the values of int64 is unbounded
+// there before clipping. Nothing is yielded for an empty or unbounded query or a resolution which is not positive.
+func AlignedWindows(query IInterval[int64], resolution time.Duration) iter.Seq[IInterval[int64]] {
+	return func(yield func(IInterval[int64]) bool) {
+		if query == nil || query.IsEmpty() || query.LowerUnbounded() || query.UpperUnbounded() || resolution <= 0 {
+			return
+		}
+		r := int64(resolution)
+		start, lowerOverflow := query.Lower()-query.Lower()%r, 0
+		if query.Lower()%r < 0 {
+			start, lowerOverflow = sub(start, r)
+		}
+		for {
+			end, upperOverflow := add(start, r)
+			if lowerOverflow != 0 {
+				// start wrapped around below the lowest int64, so adding r wraps it back to the aligned end.
+				upperOverflow = 0
+			}
+			w := query.Intersect(NewInterval[int64](start, end, true, lowerOverflow != 0, false, upperOverflow != 0))
+			if w != nil && !yield(w) {
+				return
+			}
+			if upperOverflow != 0 || end > query.Upper() {
+				return
+			}
+			start, lowerOverflow = end, 0
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package interval
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCalendarPeriod(t *testing.T) {
+	amsterdam, er := time.LoadLocation("Europe/Amsterdam")
+	if er != nil {
+		t.Skip("time zone database is not available")
+	}
+	// 2024-03-31 is the day daylight saving time starts in Amsterdam.
+	now := time.Date(2024, time.March, 31, 15, 0, 0, 0, amsterdam)
+	for _, tc := range []struct {
+		unit       CalendarUnit
+		offset     int
+		start, end time.Time
+	}{
+		{Day, 0, time.Date(2024, time.March, 31, 0, 0, 0, 0, amsterdam), time.Date(2024, time.April, 1, 0, 0, 0, 0, amsterdam)},
+		{Day, -1, time.Date(2024, time.March, 30, 0, 0, 0, 0, amsterdam), time.Date(2024, time.March, 31, 0, 0, 0, 0, amsterdam)},
+		{Week, 0, time.Date(2024, time.March, 25, 0, 0, 0, 0, amsterdam), time.Date(2024, time.April, 1, 0, 0, 0, 0, amsterdam)},
+		{Month, -1, time.Date(2024, time.February, 1, 0, 0, 0, 0, amsterdam), time.Date(2024, time.March, 1, 0, 0, 0, 0, amsterdam)},
+		{Month, -3, time.Date(2023, time.December, 1, 0, 0, 0, 0, amsterdam), time.Date(2024, time.January, 1, 0, 0, 0, 0, amsterdam)},
+		{Year, 0, time.Date(2024, time.January, 1, 0, 0, 0, 0, amsterdam), time.Date(2025, time.January, 1, 0, 0, 0, 0, amsterdam)},
+	} {
+		p := CalendarPeriod(now, tc.unit, tc.offset)
+		if !p.Equal(NewTimeInterval(tc.start, tc.end)) {
+			t.Errorf("want CalendarPeriod(%v, %v, %d) = [%v, %v) but get %s", now, tc.unit, tc.offset, tc.start, tc.end, p)
+		}
+	}
+	if d := CalendarPeriod(now, Day, 0); time.Duration(d.Upper()-d.Lower()) != 23*time.Hour {
+		t.Errorf("want the day daylight saving time starts to last 23 hours but get %v", time.Duration(d.Upper()-d.Lower()))
+	}
+}
+
+func TestLast(t *testing.T) {
+	now := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	w := Last(now, 15*time.Minute)
+	if w.Has(now.UnixNano()) || !w.Has(now.Add(-15*time.Minute).UnixNano()) {
+		t.Errorf("want Last to be the half-open window ending at now but get %s", w)
+	}
+	if !IsStale(now, now.Add(-16*time.Minute), 15*time.Minute) || IsStale(now, now.Add(-time.Minute), 15*time.Minute) {
+		t.Errorf("want data older than 15 minutes to be stale")
+	}
+}
+
+func TestAlignedWindows(t *testing.T) {
+	for n, tc := range []struct {
+		query      IInterval[int64]
+		resolution time.Duration
+		windows    []IInterval[int64]
+	}{
+		{
+			query:      NewInterval[int64](5, 25, true, false, false, false),
+			resolution: 10,
+			windows: []IInterval[int64]{
+				NewInterval[int64](5, 10, true, false, false, false),
+				NewInterval[int64](10, 20, true, false, false, false),
+				NewInterval[int64](20, 25, true, false, false, false),
+			},
+		},
+		{
+			query:      NewInterval[int64](-10, 10, false, false, true, false),
+			resolution: 10,
+			windows: []IInterval[int64]{
+				NewInterval[int64](-10, 0, false, false, false, false),
+				NewInterval[int64](0, 10, true, false, false, false),
+				NewInterval[int64](10, 10, true, false, true, false),
+			},
+		},
+		{
+			query:      NewInterval[int64](-15, -5, true, false, false, false),
+			resolution: 10,
+			windows: []IInterval[int64]{
+				NewInterval[int64](-15, -10, true, false, false, false),
+				NewInterval[int64](-10, -5, true, false, false, false),
+			},
+		},
+		{
+			query:      NewInterval[int64](0, 10, true, false, false, true),
+			resolution: 10,
+			windows:    nil,
+		},
+		{
+			query:      Closed[int64](math.MaxInt64-10, math.MaxInt64-1),
+			resolution: time.Hour,
+			windows:    []IInterval[int64]{Closed[int64](math.MaxInt64-10, math.MaxInt64-1)},
+		},
+		{
+			query:      Closed[int64](math.MaxInt64-15, math.MaxInt64),
+			resolution: 10,
+			windows: []IInterval[int64]{
+				ClosedOpen[int64](math.MaxInt64-15, math.MaxInt64-7),
+				Closed[int64](math.MaxInt64-7, math.MaxInt64),
+			},
+		},
+		{
+			query:      Closed[int64](math.MinInt64, math.MinInt64+10),
+			resolution: time.Hour,
+			windows:    []IInterval[int64]{Closed[int64](math.MinInt64, math.MinInt64+10)},
+		},
+		{
+			query:      Closed[int64](math.MinInt64, math.MinInt64+15),
+			resolution: 10,
+			windows: []IInterval[int64]{
+				ClosedOpen[int64](math.MinInt64, math.MinInt64+8),
+				Closed[int64](math.MinInt64+8, math.MinInt64+15),
+			},
+		},
+	} {
+		var windows []IInterval[int64]
+		for w := range AlignedWindows(tc.query, tc.resolution) {
+			windows = append(windows, w)
+		}
+		if len(windows) != len(tc.windows) {
+			t.Errorf("want AlignedWindows(%s, %v) = %v but get %v, counter: %d", tc.query, tc.resolution, tc.windows, windows, n)
+			continue
+		}
+		for k := range windows {
+			if !windows[k].Equal(tc.windows[k]) {
+				t.Errorf("want AlignedWindows(%s, %v) = %v but get %v, counter: %d", tc.query, tc.resolution, tc.windows, windows, n)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package interval
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// EqualWithin returns true if receiver interval and x_interval_string interval are equal but for bounds which differ
+// at most eps, as for floating point bounds which were computed differently. Whether bounds are included or
+// unbounded must be the same. Two empty intervals are equal.
+func (i *Interval[T]) EqualWithin(x IInterval[T], eps T) bool {
+	i = i.orEmpty()
+	if x == nil {
+		return i.IsEmpty()
+	}
+	if i.IsEmpty() || x.IsEmpty() {
+		return i.IsEmpty() && x.IsEmpty()
+	}
+	if i.lower.Kind == Unbounded != x.LowerUnbounded() || i.upper.Kind == Unbounded != x.UpperUnbounded() {
+		return false
+	}
+	if i.lower.Kind != Unbounded && (i.lower.Kind == ClosedBound != x.LowerIncluded() || distance(i.lower.Value, x.Lower()) > eps) {
+		return false
+	}
+	return i.upper.Kind == Unbounded || i.upper.Kind == ClosedBound == x.UpperIncluded() && distance(i.upper.Value, x.Upper()) <= eps
+}
+
+// HasWithin returns true if value is in receiver interval or at most eps outside it. With eps 0 it is Has.
+func (i *Interval[T]) HasWithin(value T, eps T) bool {
+	c := i.Explain(value)
+	switch c.Reason {
+	case Inside:
+		return true
+	case AtExcludedLower, AtExcludedUpper:
+		return eps > 0
+	case BelowLower, AboveUpper:
+		return c.Distance <= eps
+	}
+	return false
+}
+
+// distance returns |a - b|.
+func distance[T constraints.Integer | constraints.Float](a, b T) T {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,59 @@
+package interval
+
+import (
+	"testing"
+)
+
+func TestIntervalEqualWithin(t *testing.T) {
+	a, b := 0.1, 0.2
+	i := NewInterval(a+b, 1, true, false, false, false)
+	for _, tc := range []struct {
+		x     *Interval[float64]
+		eps   float64
+		equal bool
+	}{
+		{NewInterval(0.3, 1, true, false, false, false), 1e-9, true},
+		{NewInterval(0.3, 1, true, false, false, false), 0, false},
+		{NewInterval(0.3, 1, false, false, false, false), 1e-9, false},
+		{NewInterval(0.3, 1.1, true, false, false, false), 1e-9, false},
+		{NewInterval(0.3, 1.1, true, false, false, false), 0.2, true},
+		{NewInterval(0.3, 5, true, false, false, true), 1e-9, false},
+	} {
+		if e := i.EqualWithin(tc.x, tc.eps); e != tc.equal {
+			t.Errorf("want %s.EqualWithin(%s, %v) = %v but is %v", i, tc.x, tc.eps, tc.equal, e)
+		}
+	}
+	u := NewInterval(0.3, 5, true, false, false, true)
+	if !u.EqualWithin(NewInterval(0.3+1e-12, 7, true, false, false, true), 1e-9) {
+		t.Errorf("want EqualWithin to ignore the bound of an unbounded side")
+	}
+	if !NewInterval(1.0, 1, true, false, false, false).EqualWithin(NewInterval(2.0, 1, true, false, true, false), 0) || i.EqualWithin(nil, 1) {
+		t.Errorf("want EqualWithin to be true for two empty intervals and false for nil")
+	}
+}
+
+func TestIntervalHasWithin(t *testing.T) {
+	i := NewInterval(0.0, 1, false, false, true, false)
+	for _, tc := range []struct {
+		value, eps float64
+		has        bool
+	}{
+		{0.5, 0, true},
+		{0, 0, false},
+		{0, 1e-9, true},
+		{1 + 1e-12, 0, false},
+		{1 + 1e-12, 1e-9, true},
+		{-0.1, 0.05, false},
+		{-0.1, 0.2, true},
+	} {
+		if h := i.HasWithin(tc.value, tc.eps); h != tc.has {
+			t.Errorf("want %s.HasWithin(%v, %v) = %v but is %v", i, tc.value, tc.eps, tc.has, h)
+		}
+		if tc.eps == 0 && i.Has(tc.value) != tc.has {
+			t.Errorf("want %s.HasWithin(%v, 0) to be Has", i, tc.value)
+		}
+	}
+	if NewInterval(1.0, 1, false, false, false, false).HasWithin(1, 1) {
+		t.Errorf("want HasWithin of an empty interval to be false")
+	}
+}
@@ -0,0 +1,54 @@
+package interval
+
+import (
+	"unicode"
+)
+
+// ToRangeTable returns a unicode.RangeTable of the runes of s, for use with unicode.Is and unicode.In. Runes below
+// 0 and above unicode.MaxRune are left out, as a table cannot hold them.
+func ToRangeTable(s *IntervalSet[rune]) *unicode.RangeTable {
+	t := new(unicode.RangeTable)
+	for x := range s.All() {
+		lower, upper := integerBounds(x)
+		lower, upper = max(lower, 0), min(upper, unicode.MaxRune)
+		if lower > upper {
+			continue
+		}
+		if lower <= 0xFFFF {
+			hi := min(upper, 0xFFFF)
+			t.R16 = append(t.R16, unicode.Range16{Lo: uint16(lower), Hi: uint16(hi), Stride: 1})
+			if hi <= unicode.MaxLatin1 {
+				t.LatinOffset++
+			}
+			lower = hi + 1
+		}
+		if lower <= upper {
+			t.R32 = append(t.R32, unicode.Range32{Lo: uint32(lower), Hi: uint32(upper), Stride: 1})
+		}
+	}
+	return t
+}
+
+// FromRangeTable returns the set of the runes of t, such as unicode.Letter, so character classes can be combined
+// with the operations of IntervalSet. A range with a stride above 1 adds each of its runes as a point.
+func FromRangeTable(t *unicode.RangeTable) *IntervalSet[rune] {
+	var intervals []IInterval[rune]
+	for _, r := range t.R16 {
+		intervals = appendRange(intervals, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	for _, r := range t.R32 {
+		intervals = appendRange(intervals, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// appendRange appends the runes from lo to hi with stride to intervals, as one interval for stride 1.
+func appendRange(intervals []IInterval[rune], lo, hi, stride rune) []IInterval[rune] {
+	if stride <= 1 {
+		return append(intervals, Closed(lo, hi))
+	}
+	for r := lo; r <= hi; r += stride {
+		intervals = append(intervals, Point(r))
+	}
+	return intervals
+}
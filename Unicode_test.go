@@ -0,0 +1,40 @@
+package interval
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestRangeTable(t *testing.T) {
+	s := NewIntervalSet[rune](Closed('a', 'z'), Closed('0', '9'), Closed[rune](0xFFF0, 0x10010), Closed[rune](-5, -1), AtLeast[rune](0x10FFF0))
+	table := ToRangeTable(s)
+	if len(table.R16) != 3 || len(table.R32) != 2 || table.LatinOffset != 2 {
+		t.Fatalf("want 3 16-bit ranges, 2 of them Latin-1, and 2 32-bit ranges but get %+v", table)
+	}
+	for _, tc := range []struct {
+		r    rune
+		want bool
+	}{
+		{'a', true}, {'m', true}, {'A', false}, {'5', true}, {0xFFFF, true}, {0x10000, true}, {0x10011, false},
+		{unicode.MaxRune, true},
+	} {
+		if got := unicode.Is(table, tc.r); got != tc.want {
+			t.Errorf("want unicode.Is(%U) = %v but get %v", tc.r, tc.want, got)
+		}
+	}
+	back := FromRangeTable(table)
+	want := NewIntervalSet[rune](Closed('a', 'z'), Closed('0', '9'), Closed[rune](0xFFF0, 0x10010), Closed(0x10FFF0, unicode.MaxRune))
+	if !back.Equal(want) {
+		t.Errorf("want %s from the table but get %s", want, back)
+	}
+	digits := FromRangeTable(unicode.Digit)
+	letters := FromRangeTable(unicode.Letter)
+	for r := rune(0); r <= 0x2000; r++ {
+		if digits.Has(r) != unicode.IsDigit(r) || letters.Has(r) != unicode.IsLetter(r) {
+			t.Fatalf("want %U to be in the sets as in the tables", r)
+		}
+	}
+	if got := digits.Intersect(letters); !got.IsEmpty() {
+		t.Errorf("want no rune both a digit and a letter but get %s", got)
+	}
+}
@@ -0,0 +1,99 @@
+package interval
+
+import (
+	"iter"
+)
+
+// Values returns every value of receiver interval, ascending, for integer types, so that
+// for v := range x.Values() visits them all. An unbounded upper side yields values up to the highest value of T,
+// so the loop must break; an unbounded lower side, which has no first value to start from, yields nothing. Nothing
+// is yielded for floating point types, whose intervals have too many values; use Iterate for them.
+func (i *Interval[T]) Values() iter.Seq[T] {
+	i = i.orEmpty()
+	return func(yield func(T) bool) {
+		if !discrete[T]() || i.IsEmpty() || i.lower.Kind == Unbounded {
+			return
+		}
+		c := closed[T](i)
+		last := c.upper.Value
+		if c.upper.Kind == Unbounded {
+			_, last = extremes[T]()
+		}
+		for v := c.lower.Value; ; v++ {
+			if !yield(v) || v == last {
+				return
+			}
+		}
+	}
+}
+
+// Iterate returns lower, lower+step, lower+2*step and so on, as far as they are in receiver interval: an excluded
+// lower bound is skipped and an included upper bound is reached. For floating point types the values are computed
+// as lower+k*step, so rounding errors do not add up. An unbounded upper side yields values up to the highest value of
+// T, so the loop must break; nothing is yielded for an empty interval, an unbounded lower side or a step which is not
+// positive.
+func (i *Interval[T]) Iterate(step T) iter.Seq[T] {
+	i = i.orEmpty()
+	return func(yield func(T) bool) {
+		if i.IsEmpty() || i.lower.Kind == Unbounded || !(step > 0) {
+			return
+		}
+		for k, v := 0, i.lower.Value; ; k++ {
+			if k > 0 {
+				if discrete[T]() {
+					var overflow int
+					if v, overflow = add(v, step); overflow != 0 {
+						return
+					}
+				} else if v = i.lower.Value + T(k)*step; infinite(v) > 0 {
+					return
+				}
+			}
+			if v == i.lower.Value && i.lower.Kind != ClosedBound {
+				continue
+			}
+			if i.upper.Kind != Unbounded && (v > i.upper.Value || v == i.upper.Value && i.upper.Kind != ClosedBound) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SampleN returns n values evenly spaced over receiver interval, ascending. An included bound is the first or last
+// value, an excluded bound is left out as if one more value were taken at that side, so [0, 1] by 3 gives 0, 0.5 and
+// 1 and (0, 1) gives 0.25, 0.5 and 0.75. For integer types the excluded bounds are first replaced by the included
+// integers next to them and the values are rounded to the nearest integer, so they may repeat when n is larger than
+// the number of values. SampleN returns nil if receiver interval is empty or unbounded, or n is not positive.
+func (i *Interval[T]) SampleN(n int) []T {
+	i = i.orEmpty()
+	if i.IsEmpty() || i.lower.Kind == Unbounded || i.upper.Kind == Unbounded || n <= 0 {
+		return nil
+	}
+	c := i
+	if discrete[T]() {
+		c = closed[T](i)
+	}
+	first, steps := 0, n-1
+	if c.lower.Kind != ClosedBound {
+		first, steps = 1, steps+1
+	}
+	if c.upper.Kind != ClosedBound {
+		steps++
+	}
+	values := make([]T, n)
+	lower, upper := float64(c.lower.Value), float64(c.upper.Value)
+	for k := range values {
+		switch {
+		case first+k == 0:
+			values[k] = c.lower.Value
+		case first+k == steps:
+			values[k] = c.upper.Value
+		default:
+			values[k] = proportion[T](lower + (upper-lower)*float64(first+k)/float64(steps))
+		}
+	}
+	return values
+}
@@ -0,0 +1,102 @@
+package interval
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestIntervalValues(t *testing.T) {
+	for _, tc := range []struct {
+		i    IInterval[int]
+		want []int
+	}{
+		{Closed(1, 4), []int{1, 2, 3, 4}},
+		{Open(1, 4), []int{2, 3}},
+		{ClosedOpen(-2, 1), []int{-2, -1, 0}},
+		{Point(7), []int{7}},
+		{Open(3, 4), nil},
+		{Less(3), nil},
+		{(*Interval[int])(nil), nil},
+	} {
+		if got := slices.Collect(tc.i.Values()); !slices.Equal(got, tc.want) {
+			t.Errorf("want %s.Values() = %v but get %v", tc.i, tc.want, got)
+		}
+	}
+	var first []int
+	for v := range Greater(10).Values() {
+		first = append(first, v)
+		if len(first) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(first, []int{11, 12, 13}) {
+		t.Errorf("want the first values of (10,+∞) = [11 12 13] but get %v", first)
+	}
+	if got := slices.Collect(AtLeast[int8](126).Values()); !slices.Equal(got, []int8{126, 127}) {
+		t.Errorf("want [126,+∞) of int8 to stop at 127 but get %v", got)
+	}
+	if got := slices.Collect(Closed[int64](math.MaxInt64-1, math.MaxInt64).Values()); len(got) != 2 {
+		t.Errorf("want 2 values up to MaxInt64 but get %v", got)
+	}
+	for v := range Closed(0.0, 1).Values() {
+		t.Errorf("want no values of a float64 interval but get %v", v)
+	}
+}
+
+func TestIntervalIterate(t *testing.T) {
+	for _, tc := range []struct {
+		i    IInterval[float64]
+		step float64
+		want []float64
+	}{
+		{Closed(0.0, 1), 0.25, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{Open(0.0, 1), 0.25, []float64{0.25, 0.5, 0.75}},
+		{ClosedOpen(0.0, 0.3), 0.1, []float64{0, 0.1, 0.2}},
+		{Closed(0.0, 1), 0.4, []float64{0, 0.4, 0.8}},
+		{Closed(0.0, 1), 0, nil},
+		{Closed(0.0, 1), math.NaN(), nil},
+		{AtMost(1.0), 0.5, nil},
+	} {
+		if got := slices.Collect(tc.i.Iterate(tc.step)); !slices.Equal(got, tc.want) {
+			t.Errorf("want %s.Iterate(%v) = %v but get %v", tc.i, tc.step, tc.want, got)
+		}
+	}
+	if got := slices.Collect(ClosedOpen(0.0, 1).Iterate(0.1)); len(got) != 10 || got[9] != 0.9 {
+		t.Errorf("want 10 values of [0,1) by 0.1 ending with 0.9 but get %v", got)
+	}
+	if got := slices.Collect(OpenClosed(1, 10).Iterate(3)); !slices.Equal(got, []int{4, 7, 10}) {
+		t.Errorf("want (1,10] by 3 = [4 7 10] but get %v", got)
+	}
+	if got := slices.Collect(AtLeast[int8](100).Iterate(10)); !slices.Equal(got, []int8{100, 110, 120}) {
+		t.Errorf("want [100,+∞) of int8 by 10 to stop before overflow but get %v", got)
+	}
+}
+
+func TestIntervalSampleN(t *testing.T) {
+	for _, tc := range []struct {
+		i    IInterval[float64]
+		n    int
+		want []float64
+	}{
+		{Closed(0.0, 1), 3, []float64{0, 0.5, 1}},
+		{Open(0.0, 1), 3, []float64{0.25, 0.5, 0.75}},
+		{ClosedOpen(0.0, 1), 4, []float64{0, 0.25, 0.5, 0.75}},
+		{OpenClosed(0.0, 1), 2, []float64{0.5, 1}},
+		{Closed(2.0, 4), 1, []float64{2}},
+		{Point(3.0), 2, []float64{3, 3}},
+		{Closed(0.0, 1), 0, nil},
+		{AtLeast(0.0), 3, nil},
+		{Open(1.0, 1), 3, nil},
+	} {
+		if got := tc.i.SampleN(tc.n); !slices.Equal(got, tc.want) {
+			t.Errorf("want %s.SampleN(%d) = %v but get %v", tc.i, tc.n, tc.want, got)
+		}
+	}
+	if got := Open(0, 10).SampleN(3); !slices.Equal(got, []int{1, 5, 9}) {
+		t.Errorf("want (0,10) of int by 3 = [1 5 9] but get %v", got)
+	}
+	if got := Closed[int8](-128, 127).SampleN(2); !slices.Equal(got, []int8{-128, 127}) {
+		t.Errorf("want [-128,127] of int8 by 2 = [-128 127] but get %v", got)
+	}
+}
@@ -0,0 +1,109 @@
+//go:build cgo
+
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+#define INTERVAL_LOWER_INCLUDED  1
+#define INTERVAL_LOWER_UNBOUNDED 2
+#define INTERVAL_UPPER_INCLUDED  4
+#define INTERVAL_UPPER_UNBOUNDED 8
+
+typedef uintptr_t interval_handle;
+
+typedef struct {
+	double lower;
+	double upper;
+	int flags;
+} interval_t;
+*/
+import "C"
+
+import "unsafe"
+
+//export interval_new
+func interval_new(lower, upper C.double, flags C.int) C.interval_handle {
+	return C.interval_handle(newHandle(fromFlat(flat{lower: float64(lower), upper: float64(upper), flags: int(flags)})))
+}
+
+//export interval_free
+func interval_free(h C.interval_handle) {
+	free(uintptr(h))
+}
+
+// interval_get writes the interval of h to out and returns 1, or returns 0 if h is the empty interval.
+//
+//export interval_get
+func interval_get(h C.interval_handle, out *C.interval_t) C.int {
+	if h == 0 {
+		return 0
+	}
+	f := toFlat(get(uintptr(h)))
+	*out = C.interval_t{lower: C.double(f.lower), upper: C.double(f.upper), flags: C.int(f.flags)}
+	return 1
+}
+
+//export interval_has
+func interval_has(h C.interval_handle, value C.double) C.int {
+	return boolean(h != 0 && get(uintptr(h)).Has(float64(value)))
+}
+
+//export interval_contains
+func interval_contains(a, b C.interval_handle) C.int {
+	return boolean(b == 0 || a != 0 && get(uintptr(a)).Contains(get(uintptr(b))))
+}
+
+//export interval_overlaps
+func interval_overlaps(a, b C.interval_handle) C.int {
+	return boolean(a != 0 && b != 0 && get(uintptr(a)).Overlaps(get(uintptr(b))))
+}
+
+//export interval_equal
+func interval_equal(a, b C.interval_handle) C.int {
+	if a == 0 || b == 0 {
+		return boolean(a == b)
+	}
+	return boolean(get(uintptr(a)).Equal(get(uintptr(b))))
+}
+
+//export interval_intersect
+func interval_intersect(a, b C.interval_handle) C.interval_handle {
+	return C.interval_handle(intersect(uintptr(a), uintptr(b)))
+}
+
+//export interval_join
+func interval_join(a, b C.interval_handle) C.interval_handle {
+	return C.interval_handle(join(uintptr(a), uintptr(b)))
+}
+
+// interval_subtract writes handles to the parts of a below and above b to lower and upper.
+//
+//export interval_subtract
+func interval_subtract(a, b C.interval_handle, lower, upper *C.interval_handle) {
+	l, u := subtract(uintptr(a), uintptr(b))
+	*lower, *upper = C.interval_handle(l), C.interval_handle(u)
+}
+
+// interval_string returns the interval of h as text, which the caller releases with interval_free_string.
+//
+//export interval_string
+func interval_string(h C.interval_handle) *C.char {
+	if h == 0 {
+		return C.CString("")
+	}
+	return C.CString(get(uintptr(h)).String())
+}
+
+//export interval_free_string
+func interval_free_string(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func boolean(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
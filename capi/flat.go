@@ -0,0 +1,105 @@
+//go:build cgo
+
+// Command capi is a C ABI for the interval package, so that components written in other languages use the same
+// boundary semantics instead of their own. Build it as a shared library with its header:
+//
+//	go build -buildmode=c-shared -o libinterval.so ./capi
+//
+// Intervals of float64 are created with interval_new, which returns a handle, combined with operations that return
+// new handles, and released with interval_free. Handle 0 is the empty interval. interval_get flattens an interval
+// into an interval_t struct of its bounds and flags.
+package main
+
+import (
+	"runtime/cgo"
+
+	"github.com/bertverhees/interval"
+)
+
+// Flags of a flattened interval, the same as the INTERVAL_* defines of the C header.
+const (
+	lowerIncluded  = 1 << iota // INTERVAL_LOWER_INCLUDED
+	lowerUnbounded             // INTERVAL_LOWER_UNBOUNDED
+	upperIncluded              // INTERVAL_UPPER_INCLUDED
+	upperUnbounded             // INTERVAL_UPPER_UNBOUNDED
+)
+
+// flat is an interval flattened to its bounds and a bit set of flags, as passed over the C ABI.
+type flat struct {
+	lower, upper float64
+	flags        int
+}
+
+func fromFlat(f flat) *interval.Interval[float64] {
+	return interval.NewInterval(f.lower, f.upper,
+		f.flags&lowerIncluded != 0, f.flags&lowerUnbounded != 0, f.flags&upperIncluded != 0, f.flags&upperUnbounded != 0)
+}
+
+func toFlat(x interval.IInterval[float64]) flat {
+	f := flat{lower: x.Lower(), upper: x.Upper()}
+	for flag, set := range map[int]bool{
+		lowerIncluded:  x.LowerIncluded(),
+		lowerUnbounded: x.LowerUnbounded(),
+		upperIncluded:  x.UpperIncluded(),
+		upperUnbounded: x.UpperUnbounded(),
+	} {
+		if set {
+			f.flags |= flag
+		}
+	}
+	return f
+}
+
+// newHandle returns a handle to x, or 0 if x is empty.
+func newHandle(x interval.IInterval[float64]) uintptr {
+	if x == nil || x.IsEmpty() {
+		return 0
+	}
+	return uintptr(cgo.NewHandle(x))
+}
+
+// get returns the interval of handle h, or nil for handle 0.
+func get(h uintptr) interval.IInterval[float64] {
+	if h == 0 {
+		return nil
+	}
+	return cgo.Handle(h).Value().(interval.IInterval[float64])
+}
+
+func free(h uintptr) {
+	if h != 0 {
+		cgo.Handle(h).Delete()
+	}
+}
+
+// intersect, join and subtract return handles to the results of the operation on the intervals of handles a and b.
+
+func intersect(a, b uintptr) uintptr {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return newHandle(get(a).Intersect(get(b)))
+}
+
+func join(a, b uintptr) uintptr {
+	switch {
+	case a == 0:
+		return newHandle(get(b))
+	case b == 0:
+		return newHandle(get(a))
+	}
+	return newHandle(get(a).Join(get(b)))
+}
+
+func subtract(a, b uintptr) (uintptr, uintptr) {
+	switch {
+	case a == 0:
+		return 0, 0
+	case b == 0:
+		return newHandle(get(a)), 0
+	}
+	lower, upper := get(a).Subtract(get(b))
+	return newHandle(lower), newHandle(upper)
+}
+
+func main() {}
@@ -0,0 +1,55 @@
+//go:build cgo
+
+package main
+
+import (
+	"testing"
+
+	"github.com/bertverhees/interval"
+)
+
+func TestFlat(t *testing.T) {
+	for _, f := range []flat{
+		{0, 5, lowerIncluded | upperIncluded},
+		{0, 5, lowerIncluded},
+		{0, 5, lowerUnbounded | upperIncluded},
+		{0, 5, lowerIncluded | upperUnbounded},
+	} {
+		if g := toFlat(fromFlat(f)); g != f {
+			t.Errorf("want toFlat(fromFlat(%v)) = %v but is %v", f, f, g)
+		}
+	}
+}
+
+func TestHandles(t *testing.T) {
+	a := newHandle(fromFlat(flat{0, 10, lowerIncluded}))
+	b := newHandle(fromFlat(flat{5, 15, lowerIncluded | upperIncluded}))
+	defer free(a)
+	defer free(b)
+	if h := newHandle(fromFlat(flat{5, 5, lowerIncluded})); h != 0 {
+		t.Errorf("want the handle of an empty interval to be 0 but is %v", h)
+	}
+	i := intersect(a, b)
+	defer free(i)
+	if x := get(i); !x.Equal(interval.NewInterval(5.0, 10, true, false, false, false)) {
+		t.Errorf("want intersect of [0, 10) and [5, 15] to be [5, 10) but is %v", x)
+	}
+	j := join(a, b)
+	defer free(j)
+	if x := get(j); !x.Equal(interval.NewInterval(0.0, 15, true, false, true, false)) {
+		t.Errorf("want join of [0, 10) and [5, 15] to be [0, 15] but is %v", x)
+	}
+	if j := join(a, 0); !get(j).Equal(get(a)) {
+		t.Errorf("want join of [0, 10) and the empty interval to be [0, 10) but is %v", get(j))
+	} else {
+		free(j)
+	}
+	lower, upper := subtract(a, b)
+	defer free(lower)
+	if x := get(lower); upper != 0 || !x.Equal(interval.NewInterval(0.0, 5, true, false, false, false)) {
+		t.Errorf("want [0, 10) minus [5, 15] to be [0, 5) but is %v and %v", x, get(upper))
+	}
+	if i := intersect(a, 0); i != 0 {
+		t.Errorf("want intersect with the empty interval to be 0 but is %v", i)
+	}
+}
@@ -0,0 +1,230 @@
+// Package oracle tests implementations of interval.IInterval against a slow but obviously correct model, in which an
+// interval is the set of the points of a small domain it has. Every pair of intervals with bounds in the domain is
+// checked, so a wrong boundary case cannot slip through as it can between the cases of a table.
+//
+// Intervals have integer bounds. For integer types the model has the integers of the domain, so [3, 4) and [3, 3]
+// are the same set; for floating point types it also has the points halfway between them, which tells open from
+// closed bounds as a continuous domain does. Downstream implementations are checked by passing their constructor to
+// New.
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/bertverhees/interval"
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	// ErrMismatch is returned when an operation does not agree with the model.
+	ErrMismatch = errors.New("interval/fuzz/oracle: operation does not agree with the model")
+	// ErrDomain is returned by New for bounds the model cannot sample around.
+	ErrDomain = errors.New("interval/fuzz/oracle: domain does not fit in its type")
+)
+
+// Constructor makes an interval, like interval.NewInterval.
+type Constructor[T constraints.Integer | constraints.Float] func(lower, upper T, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool) interval.IInterval[T]
+
+// Oracle checks intervals made by a Constructor against the model over the bounds lo to hi.
+type Oracle[T constraints.Integer | constraints.Float] struct {
+	lo, hi      T
+	discrete    bool
+	newInterval Constructor[T]
+}
+
+// New returns an oracle for intervals with bounds from lo to hi made by newInterval, or by interval.NewInterval if
+// it is nil. The model samples from lo-1 to hi+1, so unbounded sides reach beyond every bound; New returns ErrDomain
+// if lo is above hi or lo-1 or hi+1 is not a distinct value of T and of float64, like hi at the largest value of an
+// integer type, where counting up to it would never end.
+func New[T constraints.Integer | constraints.Float](lo, hi T, newInterval Constructor[T]) (*Oracle[T], error) {
+	if !(lo <= hi && lo-1 < lo && hi+1 > hi && float64(lo)-1 < float64(lo) && float64(hi)+1 > float64(hi)) {
+		return nil, fmt.Errorf("%w: %v to %v", ErrDomain, lo, hi)
+	}
+	o := &Oracle[T]{lo: lo, hi: hi, discrete: T(1)/2 == 0, newInterval: newInterval}
+	if o.newInterval == nil {
+		o.newInterval = func(lower, upper T, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool) interval.IInterval[T] {
+			return interval.NewInterval(lower, upper, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded)
+		}
+	}
+	return o, nil
+}
+
+// Intervals returns every interval with bounds from lo to hi, with every combination of flags.
+func (o *Oracle[T]) Intervals() iter.Seq[interval.IInterval[T]] {
+	return func(yield func(interval.IInterval[T]) bool) {
+		for lower := o.lo; lower <= o.hi; lower++ {
+			for upper := o.lo; upper <= o.hi; upper++ {
+				for flags := 0; flags < 16; flags++ {
+					if !yield(o.newInterval(lower, upper, flags&1 != 0, flags&2 != 0, flags&4 != 0, flags&8 != 0)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Run checks every pair of Intervals and returns the first mismatch.
+func (o *Oracle[T]) Run() error {
+	for x := range o.Intervals() {
+		for y := range o.Intervals() {
+			if er := o.Check(x, y); er != nil {
+				return er
+			}
+		}
+	}
+	return nil
+}
+
+// Check compares Has, IsEmpty, Contains, Intersect, Subtract, Adjoin and Encompass of x and y with the model. Adjoin
+// is only checked when it joins x and y. The operations are done on copies, as some change their operands.
+func (o *Oracle[T]) Check(x, y interval.IInterval[T]) error {
+	px, py := o.points(x), o.points(y)
+	for k, v := range o.values() {
+		if x.Has(T(v)) != px[k] {
+			return o.mismatch("Has", x, v, x.Has(T(v)), px[k])
+		}
+	}
+	if x.IsEmpty() != isEmpty(px) {
+		return o.mismatch("IsEmpty", x, nil, x.IsEmpty(), isEmpty(px))
+	}
+	if got, want := o.copy(x).Contains(o.copy(y)), subset(py, px); got != want {
+		return o.mismatch("Contains", x, y, got, want)
+	}
+	if got, want := o.points(o.copy(x).Intersect(o.copy(y))), and(px, py); !slices.Equal(got, want) {
+		return o.mismatch("Intersect", x, y, o.format(got), o.format(want))
+	}
+	lower, upper := o.copy(x).Subtract(o.copy(y))
+	pl, pu := o.points(lower), o.points(upper)
+	if got, want := or(pl, pu), and(px, not(py)); !slices.Equal(got, want) || !before(pl, pu) {
+		return o.mismatch("Subtract", x, y, o.format(pl)+" and "+o.format(pu), o.format(want))
+	}
+	if adjoined := o.copy(x).Adjoin(o.copy(y)); adjoined != nil {
+		if got, want := o.points(adjoined), or(px, py); !slices.Equal(got, want) || !slices.Equal(want, hull(want)) {
+			return o.mismatch("Adjoin", x, y, o.format(got), o.format(want))
+		}
+	}
+	if got, want := o.points(o.copy(x).Encompass(o.copy(y))), hull(or(px, py)); !slices.Equal(got, want) {
+		return o.mismatch("Encompass", x, y, o.format(got), o.format(want))
+	}
+	return nil
+}
+
+func (o *Oracle[T]) mismatch(op string, x interval.IInterval[T], y any, got, want any) error {
+	if y == nil {
+		return fmt.Errorf("%w: %v.%s() = %v, want %v", ErrMismatch, x, op, got, want)
+	}
+	return fmt.Errorf("%w: %v.%s(%v) = %v, want %v", ErrMismatch, x, op, y, got, want)
+}
+
+func (o *Oracle[T]) copy(x interval.IInterval[T]) interval.IInterval[T] {
+	return o.newInterval(x.Lower(), x.Upper(), x.LowerIncluded(), x.LowerUnbounded(), x.UpperIncluded(), x.UpperUnbounded())
+}
+
+// values returns the points of the domain, ascending: the integers from lo-1 to hi+1 and, for floating point types,
+// the points halfway between them.
+func (o *Oracle[T]) values() []float64 {
+	var values []float64
+	step := 0.5
+	if o.discrete {
+		step = 1
+	}
+	for v := float64(o.lo) - 1; v <= float64(o.hi)+1; v += step {
+		values = append(values, v)
+	}
+	return values
+}
+
+// points returns which of the values x has, read from its bounds and flags alone. nil has none.
+func (o *Oracle[T]) points(x interval.IInterval[T]) []bool {
+	values := o.values()
+	points := make([]bool, len(values))
+	if x == nil {
+		return points
+	}
+	lower, upper := float64(x.Lower()), float64(x.Upper())
+	for k, v := range values {
+		aboveLower := x.LowerUnbounded() || v > lower || v == lower && x.LowerIncluded()
+		belowUpper := x.UpperUnbounded() || v < upper || v == upper && x.UpperIncluded()
+		points[k] = aboveLower && belowUpper
+	}
+	return points
+}
+
+// format writes points as the values they have, like [0 0.5 1].
+func (o *Oracle[T]) format(points []bool) string {
+	var has []float64
+	for k, v := range o.values() {
+		if points[k] {
+			has = append(has, v)
+		}
+	}
+	return fmt.Sprint(has)
+}
+
+func isEmpty(p []bool) bool {
+	return !slices.Contains(p, true)
+}
+
+func subset(a, b []bool) bool {
+	for k := range a {
+		if a[k] && !b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+func and(a, b []bool) []bool {
+	r := make([]bool, len(a))
+	for k := range a {
+		r[k] = a[k] && b[k]
+	}
+	return r
+}
+
+func or(a, b []bool) []bool {
+	r := make([]bool, len(a))
+	for k := range a {
+		r[k] = a[k] || b[k]
+	}
+	return r
+}
+
+func not(a []bool) []bool {
+	r := make([]bool, len(a))
+	for k := range a {
+		r[k] = !a[k]
+	}
+	return r
+}
+
+// hull returns the points from the first to the last point of p.
+func hull(p []bool) []bool {
+	r := make([]bool, len(p))
+	first, last := slices.Index(p, true), lastIndex(p)
+	if first >= 0 {
+		for k := first; k <= last; k++ {
+			r[k] = true
+		}
+	}
+	return r
+}
+
+// before returns true if every point of a is below every point of b.
+func before(a, b []bool) bool {
+	return isEmpty(a) || isEmpty(b) || slices.Index(b, true) > lastIndex(a)
+}
+
+// lastIndex returns the index of the last point of p, or -1 if it has none.
+func lastIndex(p []bool) int {
+	for k := len(p) - 1; k >= 0; k-- {
+		if p[k] {
+			return k
+		}
+	}
+	return -1
+}
@@ -0,0 +1,92 @@
+package oracle
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/bertverhees/interval"
+)
+
+func TestRunFloat(t *testing.T) {
+	o, er := New[float64](-2, 2, nil)
+	if er != nil {
+		t.Fatal(er)
+	}
+	if er := o.Run(); er != nil {
+		t.Error(er)
+	}
+}
+
+func TestRunInt(t *testing.T) {
+	o, er := New[int](-2, 2, nil)
+	if er != nil {
+		t.Fatal(er)
+	}
+	if er := o.Run(); er != nil {
+		t.Error(er)
+	}
+}
+
+func TestNewDomain(t *testing.T) {
+	if _, er := New[int8](120, math.MaxInt8, nil); !errors.Is(er, ErrDomain) {
+		t.Errorf("want %v for hi at the largest int8 but get %v", ErrDomain, er)
+	}
+	if _, er := New[int8](math.MinInt8, 0, nil); !errors.Is(er, ErrDomain) {
+		t.Errorf("want %v for lo at the smallest int8 but get %v", ErrDomain, er)
+	}
+	if _, er := New[int](2, -2, nil); !errors.Is(er, ErrDomain) {
+		t.Errorf("want %v for lo above hi but get %v", ErrDomain, er)
+	}
+	if _, er := New(math.NaN(), 0, nil); !errors.Is(er, ErrDomain) {
+		t.Errorf("want %v for NaN but get %v", ErrDomain, er)
+	}
+	o, er := New[int8](math.MinInt8+1, math.MaxInt8-1, nil)
+	if er != nil {
+		t.Fatalf("want the widest int8 domain accepted but get %v", er)
+	}
+	n := 0
+	for range o.Intervals() {
+		n++
+	}
+	if n != 254*254*16 {
+		t.Errorf("want %d intervals of the widest int8 domain but get %d", 254*254*16, n)
+	}
+}
+
+// closedHas is an interval whose Has ignores whether its bounds are included.
+type closedHas struct {
+	*interval.Interval[float64]
+}
+
+func (c closedHas) Has(value float64) bool {
+	return (c.LowerUnbounded() || value >= c.Lower()) && (c.UpperUnbounded() || value <= c.Upper())
+}
+
+func TestRunFindsMismatch(t *testing.T) {
+	o, er := New(-2, 2, func(lower, upper float64, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded bool) interval.IInterval[float64] {
+		return closedHas{interval.NewInterval(lower, upper, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded)}
+	})
+	if er != nil {
+		t.Fatal(er)
+	}
+	if er := o.Run(); !errors.Is(er, ErrMismatch) {
+		t.Errorf("want Run to find the mismatch of Has but get %v", er)
+	}
+}
+
+func FuzzCheck(f *testing.F) {
+	f.Add(int8(-2), int8(2), int8(0), int8(3), uint8(0x5), uint8(0x4))
+	f.Add(int8(0), int8(0), int8(0), int8(1), uint8(0xf), uint8(0x1))
+	o, er := New[int](-128, 127, nil)
+	if er != nil {
+		f.Fatal(er)
+	}
+	f.Fuzz(func(t *testing.T, xLower, xUpper, yLower, yUpper int8, xFlags, yFlags uint8) {
+		x := interval.NewInterval(int(xLower), int(xUpper), xFlags&1 != 0, xFlags&2 != 0, xFlags&4 != 0, xFlags&8 != 0)
+		y := interval.NewInterval(int(yLower), int(yUpper), yFlags&1 != 0, yFlags&2 != 0, yFlags&4 != 0, yFlags&8 != 0)
+		if er := o.Check(x, y); er != nil {
+			t.Error(er)
+		}
+	})
+}
@@ -1,5 +1,5 @@
 module github.com/bertverhees/interval
 
-go 1.21.6
+go 1.23
 
 require golang.org/x/exp v0.0.0-20240119083558-1b970713d09a
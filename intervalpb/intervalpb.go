@@ -0,0 +1,226 @@
+// Package intervalpb exchanges intervals as the protobuf message Interval of interval.proto, as between services
+// talking gRPC. Interval is written by hand, so the package needs no protobuf runtime; Marshal and Unmarshal read and
+// write the protobuf wire format, which code generated from interval.proto in any language reads and writes too.
+//
+// Interval is not a proto.Message, so it cannot be a field of another message or a gRPC request itself. For that,
+// generate Go code from interval.proto, which goes to its go_package intervalv1 instead of this package, and pass
+// the bytes of Marshal to proto.Unmarshal of the generated message, or the bytes of proto.Marshal to Unmarshal.
+package intervalpb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bertverhees/interval"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrWire is returned by Unmarshal for bytes which are not an Interval message.
+var ErrWire = errors.New("interval/intervalpb: invalid wire format")
+
+// Field numbers of interval.proto.
+const (
+	fieldVersion       = 1
+	fieldLowerInt      = 2
+	fieldLowerFloat    = 3
+	fieldUpperInt      = 4
+	fieldUpperFloat    = 5
+	fieldLowerIncluded = 6
+	fieldUpperIncluded = 7
+	fieldEmpty         = 8
+)
+
+// Wire types of the protobuf encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Interval is the message Interval of interval.proto. Of LowerInt and LowerFloat at most one is set, and none for an
+// unbounded lower side; likewise for the upper side.
+type Interval struct {
+	Version       uint32
+	LowerInt      *int64
+	LowerFloat    *float64
+	UpperInt      *int64
+	UpperFloat    *float64
+	LowerIncluded bool
+	UpperIncluded bool
+	Empty         bool
+}
+
+// ToProto returns x as a message. nil and every empty interval become a message with Empty set.
+func ToProto[T constraints.Integer | constraints.Float](x interval.IInterval[T]) *Interval {
+	m := &Interval{Version: interval.SchemaVersion}
+	if x == nil || x.IsEmpty() {
+		m.Empty = true
+		return m
+	}
+	integer := T(1)/2 == 0
+	if lower, ok := x.LowerValue(); ok {
+		if integer {
+			m.LowerInt = ptr(int64(lower))
+		} else {
+			m.LowerFloat = ptr(float64(lower))
+		}
+		m.LowerIncluded = x.LowerIncluded()
+	}
+	if upper, ok := x.UpperValue(); ok {
+		if integer {
+			m.UpperInt = ptr(int64(upper))
+		} else {
+			m.UpperFloat = ptr(float64(upper))
+		}
+		m.UpperIncluded = x.UpperIncluded()
+	}
+	return m
+}
+
+// FromProto returns the interval of m, with integer or floating point bounds converted to T. Bounds which do not make
+// a valid interval return the errors of interval.NewIntervalChecked.
+func FromProto[T constraints.Integer | constraints.Float](m *Interval) (*interval.Interval[T], error) {
+	if er := interval.CheckSchemaVersion(int(m.Version)); er != nil {
+		return nil, er
+	}
+	if m.Empty {
+		return interval.Empty[T](), nil
+	}
+	lower, lowerOk := bound[T](m.LowerInt, m.LowerFloat)
+	upper, upperOk := bound[T](m.UpperInt, m.UpperFloat)
+	return interval.NewIntervalChecked(lower, upper, m.LowerIncluded, !lowerOk, m.UpperIncluded, !upperOk)
+}
+
+func bound[T constraints.Integer | constraints.Float](i *int64, f *float64) (T, bool) {
+	switch {
+	case i != nil:
+		return T(*i), true
+	case f != nil:
+		return T(*f), true
+	}
+	return 0, false
+}
+
+func ptr[V any](v V) *V {
+	return &v
+}
+
+// Marshal returns m in the protobuf wire format. Fields are written in field number order, and fields with their
+// default value are left out except the set field of a oneof.
+func (m *Interval) Marshal() []byte {
+	var b []byte
+	if m.Version != 0 {
+		b = appendVarint(b, fieldVersion, uint64(m.Version))
+	}
+	b = appendBound(b, fieldLowerInt, m.LowerInt, fieldLowerFloat, m.LowerFloat)
+	b = appendBound(b, fieldUpperInt, m.UpperInt, fieldUpperFloat, m.UpperFloat)
+	for _, f := range []struct {
+		number int
+		set    bool
+	}{{fieldLowerIncluded, m.LowerIncluded}, {fieldUpperIncluded, m.UpperIncluded}, {fieldEmpty, m.Empty}} {
+		if f.set {
+			b = appendVarint(b, f.number, 1)
+		}
+	}
+	return b
+}
+
+// Unmarshal reads m from the protobuf wire format. Unknown fields are skipped, as protobuf requires.
+func (m *Interval) Unmarshal(b []byte) error {
+	*m = Interval{}
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 || key>>3 == 0 {
+			return fmt.Errorf("%w: bad key", ErrWire)
+		}
+		b = b[n:]
+		number, wire := int(key>>3), key&7
+		var v uint64
+		switch wire {
+		case wireVarint:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return fmt.Errorf("%w: bad varint of field %d", ErrWire, number)
+			}
+		case wireFixed64:
+			if len(b) < 8 {
+				return fmt.Errorf("%w: short fixed64 of field %d", ErrWire, number)
+			}
+			v, n = binary.LittleEndian.Uint64(b), 8
+		case wireFixed32:
+			if len(b) < 4 {
+				return fmt.Errorf("%w: short fixed32 of field %d", ErrWire, number)
+			}
+			n = 4
+		case wireBytes:
+			size, k := binary.Uvarint(b)
+			if k <= 0 || uint64(len(b)-k) < size {
+				return fmt.Errorf("%w: bad length of field %d", ErrWire, number)
+			}
+			n = k + int(size)
+		default:
+			return fmt.Errorf("%w: wire type %d of field %d", ErrWire, wire, number)
+		}
+		b = b[n:]
+		if !m.set(number, wire, v) {
+			return fmt.Errorf("%w: wire type %d of field %d", ErrWire, wire, number)
+		}
+	}
+	return nil
+}
+
+// set stores the value v of field number, and returns false if the field has another wire type.
+func (m *Interval) set(number int, wire, v uint64) bool {
+	varint, fixed64 := wire == wireVarint, wire == wireFixed64
+	switch number {
+	case fieldVersion:
+		m.Version = uint32(v)
+		return varint
+	case fieldLowerInt:
+		m.LowerInt, m.LowerFloat = ptr(unzigzag(v)), nil
+		return varint
+	case fieldLowerFloat:
+		m.LowerFloat, m.LowerInt = ptr(math.Float64frombits(v)), nil
+		return fixed64
+	case fieldUpperInt:
+		m.UpperInt, m.UpperFloat = ptr(unzigzag(v)), nil
+		return varint
+	case fieldUpperFloat:
+		m.UpperFloat, m.UpperInt = ptr(math.Float64frombits(v)), nil
+		return fixed64
+	case fieldLowerIncluded:
+		m.LowerIncluded = v != 0
+		return varint
+	case fieldUpperIncluded:
+		m.UpperIncluded = v != 0
+		return varint
+	case fieldEmpty:
+		m.Empty = v != 0
+		return varint
+	}
+	return true
+}
+
+func appendBound(b []byte, intField int, i *int64, floatField int, f *float64) []byte {
+	switch {
+	case i != nil:
+		return appendVarint(b, intField, uint64(*i<<1^*i>>63))
+	case f != nil:
+		b = binary.AppendUvarint(b, uint64(floatField)<<3|wireFixed64)
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(*f))
+	}
+	return b
+}
+
+func appendVarint(b []byte, field int, v uint64) []byte {
+	b = binary.AppendUvarint(b, uint64(field)<<3|wireVarint)
+	return binary.AppendUvarint(b, v)
+}
+
+// unzigzag returns the sint64 of its zigzag encoding.
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
@@ -0,0 +1,66 @@
+package intervalpb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/bertverhees/interval"
+)
+
+func TestMarshal(t *testing.T) {
+	for _, tc := range []struct {
+		m    *Interval
+		wire string
+	}{
+		{ToProto[int](interval.ClosedOpen(0, 5)), "\x08\x02\x10\x00\x20\x0a\x30\x01"},
+		{ToProto[int](interval.AtMost(-1)), "\x08\x02\x20\x01\x38\x01"},
+		{ToProto[float64](interval.Greater(0.5)), "\x08\x02\x19\x00\x00\x00\x00\x00\x00\xe0\x3f"},
+		{ToProto[int](interval.All[int]()), "\x08\x02"},
+		{ToProto[int](nil), "\x08\x02\x40\x01"},
+	} {
+		if b := tc.m.Marshal(); string(b) != tc.wire {
+			t.Errorf("want Marshal() = %q but get %q", tc.wire, b)
+		}
+		var m Interval
+		if er := m.Unmarshal([]byte(tc.wire)); er != nil || string(m.Marshal()) != tc.wire {
+			t.Errorf("want Unmarshal(%q) to read the message back but get %+v, %v", tc.wire, m, er)
+		}
+	}
+	var m Interval
+	if er := m.Unmarshal([]byte("\x08\x02\x10\x0a\x4a\x02hi\x55\x00\x00\x00\x00")); er != nil || m.LowerInt == nil || *m.LowerInt != 5 {
+		t.Errorf("want Unmarshal to skip unknown fields but get %+v, %v", m, er)
+	}
+	for _, wire := range []string{"\x08", "\x19\x00\x00", "\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01", "\x00\x00", "\x4a\x05hi", "\x11\x00\x00\x00\x00\x00\x00\x00\x00", "\x0b"} {
+		if er := m.Unmarshal([]byte(wire)); !errors.Is(er, ErrWire) {
+			t.Errorf("want Unmarshal(%q) to fail with ErrWire but get %v", wire, er)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	for _, x := range []*interval.Interval[int64]{interval.ClosedOpen[int64](math.MinInt64, math.MaxInt64), interval.AtLeast[int64](-7), interval.All[int64](), interval.Empty[int64]()} {
+		var m Interval
+		if er := m.Unmarshal(ToProto[int64](x).Marshal()); er != nil {
+			t.Errorf("want the message of %s to be read but get %v", x, er)
+		}
+		if y, er := FromProto[int64](&m); er != nil || !y.Equal(x) {
+			t.Errorf("want FromProto(ToProto(%s)) = %s but get %v, %v", x, x, y, er)
+		}
+	}
+	if y, er := FromProto[float64](ToProto[int](interval.Closed(1, 3))); er != nil || !y.Equal(interval.Closed(1.0, 3)) {
+		t.Errorf("want integer bounds to convert to float64 but get %v, %v", y, er)
+	}
+	for _, tc := range []struct {
+		m   *Interval
+		err error
+	}{
+		{&Interval{Version: interval.SchemaVersion + 1}, interval.ErrSchemaVersion},
+		{&Interval{LowerInt: ptr[int64](5), UpperInt: ptr[int64](1)}, interval.ErrReversedBounds},
+		{&Interval{UpperFloat: ptr(1.5), LowerIncluded: true}, interval.ErrUnboundedIncluded},
+	} {
+		if _, er := FromProto[float64](tc.m); !errors.Is(er, tc.err) {
+			t.Errorf("want FromProto(%+v) to fail with %v but get %v", tc.m, tc.err, er)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+//go:build js && wasm
+
+package wasm
+
+import "syscall/js"
+
+func init() {
+	js.Global().Set("interval", js.ValueOf(map[string]any{
+		"new": js.FuncOf(func(this js.Value, args []js.Value) any {
+			return result(New(args[0].Float(), args[1].Float(), args[2].String()))
+		}),
+		"has": js.FuncOf(func(this js.Value, args []js.Value) any {
+			return result(Has(args[0].String(), args[1].Float()))
+		}),
+		"contains":  binary(Contains),
+		"overlaps":  binary(Overlaps),
+		"intersect": binary(Intersect),
+		"join":      binary(Join),
+		"subtract":  binary(Subtract),
+		"format": js.FuncOf(func(this js.Value, args []js.Value) any {
+			return result(Format(args[0].String()))
+		}),
+	}))
+}
+
+// binary wraps a function of two intervals of JSON for JavaScript.
+func binary[R string | bool](f func(x, y string) (R, error)) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		return result(f(args[0].String(), args[1].String()))
+	})
+}
+
+// result returns v to JavaScript, or an Error for er.
+func result[R string | bool](v R, er error) any {
+	if er != nil {
+		return js.Global().Get("Error").New(er.Error())
+	}
+	return v
+}
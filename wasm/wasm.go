@@ -0,0 +1,308 @@
+// Package wasm is a facade of the interval package for JavaScript, as in a browser running it compiled to js/wasm.
+// It takes and returns only strings, float64 and bool, and passes intervals as JSON, so it needs no reflection.
+//
+// An interval is written in the JSON of the interval package, so browser and backend exchange intervals as they are:
+// {"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}, with v the interval.SchemaVersion, null
+// for an unbounded side, and the empty interval as null. Built for js/wasm the functions are registered on the global
+// object "interval".
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bertverhees/interval"
+)
+
+// ErrBounds is returned for bounds which are not one of "[]", "[)", "(]" and "()".
+var ErrBounds = errors.New("interval/wasm: bounds must be one of [], [), (] and ()")
+
+// ErrJSON is returned for JSON which is not an interval as written by this package.
+var ErrJSON = errors.New("interval/wasm: invalid interval JSON")
+
+// New returns the JSON of the interval from lower to upper with bounds like "[)". An infinite lower or upper, as
+// -Infinity or Infinity in JavaScript, makes that side unbounded.
+func New(lower, upper float64, bounds string) (string, error) {
+	if len(bounds) != 2 || !strings.ContainsRune("[(", rune(bounds[0])) || !strings.ContainsRune("])", rune(bounds[1])) {
+		return "", fmt.Errorf("%w: %q", ErrBounds, bounds)
+	}
+	lowerUnbounded, upperUnbounded := math.IsInf(lower, 0), math.IsInf(upper, 0)
+	return Marshal(interval.NewInterval(lower, upper,
+		bounds[0] == '[' && !lowerUnbounded, lowerUnbounded, bounds[1] == ']' && !upperUnbounded, upperUnbounded)), nil
+}
+
+// Has returns true if value is in the interval of JSON x.
+func Has(x string, value float64) (bool, error) {
+	i, er := Unmarshal(x)
+	if er != nil || i == nil {
+		return false, er
+	}
+	return i.Has(value), nil
+}
+
+// Contains returns true if the interval of JSON x contains the interval of JSON y.
+func Contains(x, y string) (bool, error) {
+	i, j, er := unmarshalPair(x, y)
+	switch {
+	case er != nil:
+		return false, er
+	case j == nil:
+		return true, nil
+	case i == nil:
+		return false, nil
+	}
+	return i.Contains(j), nil
+}
+
+// Overlaps returns true if the intervals of JSON x and y have a value in common.
+func Overlaps(x, y string) (bool, error) {
+	i, j, er := unmarshalPair(x, y)
+	if er != nil || i == nil || j == nil {
+		return false, er
+	}
+	return i.Overlaps(j), nil
+}
+
+// Intersect returns the JSON of the intersection of the intervals of JSON x and y.
+func Intersect(x, y string) (string, error) {
+	i, j, er := unmarshalPair(x, y)
+	if er != nil || i == nil || j == nil {
+		return "null", er
+	}
+	return Marshal(i.Intersect(j)), nil
+}
+
+// Join returns the JSON of the smallest interval containing the intervals of JSON x and y.
+func Join(x, y string) (string, error) {
+	i, j, er := unmarshalPair(x, y)
+	switch {
+	case er != nil:
+		return "null", er
+	case i == nil:
+		return Marshal(j), nil
+	}
+	return Marshal(i.Join(j)), nil
+}
+
+// Subtract returns a JSON array of the parts of the interval of JSON x outside the interval of JSON y, ascending.
+func Subtract(x, y string) (string, error) {
+	i, j, er := unmarshalPair(x, y)
+	if er != nil {
+		return "[]", er
+	}
+	var parts []string
+	switch {
+	case i == nil:
+	case j == nil:
+		parts = append(parts, Marshal(i))
+	default:
+		lower, upper := i.Subtract(j)
+		for _, p := range []interval.IInterval[float64]{lower, upper} {
+			if p != nil && !p.IsEmpty() {
+				parts = append(parts, Marshal(p))
+			}
+		}
+	}
+	return "[" + strings.Join(parts, ",") + "]", nil
+}
+
+// Format returns the interval of JSON x as text, like "[0,5)", or "" for the empty interval.
+func Format(x string) (string, error) {
+	i, er := Unmarshal(x)
+	if er != nil || i == nil {
+		return "", er
+	}
+	return i.String(), nil
+}
+
+// Marshal returns the JSON of x, as interval.Interval.MarshalJSON writes it.
+func Marshal(x interval.IInterval[float64]) string {
+	if i, ok := x.(*interval.Interval[float64]); x == nil || ok && i == nil || x.IsEmpty() {
+		return "null"
+	}
+	var b strings.Builder
+	b.WriteString(`{"v":`)
+	b.WriteString(strconv.Itoa(interval.SchemaVersion))
+	b.WriteString(`,"lower":`)
+	writeBound(&b, x.Lower(), x.LowerUnbounded())
+	b.WriteString(`,"upper":`)
+	writeBound(&b, x.Upper(), x.UpperUnbounded())
+	b.WriteString(`,"lowerIncluded":`)
+	b.WriteString(strconv.FormatBool(x.LowerIncluded() && !x.LowerUnbounded()))
+	b.WriteString(`,"upperIncluded":`)
+	b.WriteString(strconv.FormatBool(x.UpperIncluded() && !x.UpperUnbounded()))
+	b.WriteByte('}')
+	return b.String()
+}
+
+// writeBound writes v as encoding/json writes a float64, or null for an unbounded side.
+func writeBound(b *strings.Builder, v float64, unbounded bool) {
+	if unbounded {
+		b.WriteString("null")
+		return
+	}
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	s := strconv.FormatFloat(v, format, -1, 64)
+	if format == 'e' {
+		// Like encoding/json, write 1e-07 as 1e-7.
+		if n := len(s); n >= 4 && s[n-4] == 'e' && s[n-3] == '-' && s[n-2] == '0' {
+			s = s[:n-2] + s[n-1:]
+		}
+	}
+	b.WriteString(s)
+}
+
+// Unmarshal returns the interval of JSON s as written by Marshal or interval.Interval.MarshalJSON, or nil for null.
+// Fields may be in any order; a missing bound is unbounded and a missing included flag false, as the interval package
+// reads them. JSON without v is read as written before it was versioned, and bounds which do not make a valid
+// interval return the errors of interval.NewIntervalChecked.
+func Unmarshal(s string) (*interval.Interval[float64], error) {
+	d := decoder{s: s}
+	if d.literal("null") {
+		if !d.end() {
+			return nil, fmt.Errorf("%w: %q", ErrJSON, s)
+		}
+		return nil, nil
+	}
+	var lower, upper float64
+	lowerUnbounded, upperUnbounded, lowerIncluded, upperIncluded := true, true, false, false
+	if !d.byte('{') {
+		return nil, fmt.Errorf("%w: %q is not an object", ErrJSON, s)
+	}
+	for first := true; !d.byte('}'); first = false {
+		if !first && !d.byte(',') {
+			return nil, fmt.Errorf("%w: %q has no ',' between fields", ErrJSON, s)
+		}
+		key, ok := d.string()
+		if !ok || !d.byte(':') {
+			return nil, fmt.Errorf("%w: %q has no field name", ErrJSON, s)
+		}
+		switch key {
+		case "v":
+			v, null, ok := d.number()
+			if !ok || null || v != float64(int(v)) {
+				return nil, fmt.Errorf("%w: v of %q is not an integer", ErrJSON, s)
+			}
+			if er := interval.CheckSchemaVersion(int(v)); er != nil {
+				return nil, er
+			}
+		case "lower":
+			if lower, lowerUnbounded, ok = d.number(); !ok {
+				return nil, fmt.Errorf("%w: lower of %q is not a number or null", ErrJSON, s)
+			}
+		case "upper":
+			if upper, upperUnbounded, ok = d.number(); !ok {
+				return nil, fmt.Errorf("%w: upper of %q is not a number or null", ErrJSON, s)
+			}
+		case "lowerIncluded":
+			if lowerIncluded, ok = d.boolean(); !ok {
+				return nil, fmt.Errorf("%w: lowerIncluded of %q is not a boolean", ErrJSON, s)
+			}
+		case "upperIncluded":
+			if upperIncluded, ok = d.boolean(); !ok {
+				return nil, fmt.Errorf("%w: upperIncluded of %q is not a boolean", ErrJSON, s)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %q has unknown field %q", ErrJSON, s, key)
+		}
+	}
+	if !d.end() {
+		return nil, fmt.Errorf("%w: %q has text after the object", ErrJSON, s)
+	}
+	return interval.NewIntervalChecked(lower, upper, lowerIncluded, lowerUnbounded, upperIncluded, upperUnbounded)
+}
+
+func unmarshalPair(x, y string) (*interval.Interval[float64], *interval.Interval[float64], error) {
+	i, er := Unmarshal(x)
+	if er != nil {
+		return nil, nil, er
+	}
+	j, er := Unmarshal(y)
+	if er != nil {
+		return nil, nil, er
+	}
+	if i == nil || i.IsEmpty() {
+		i = nil
+	}
+	if j == nil || j.IsEmpty() {
+		j = nil
+	}
+	return i, j, nil
+}
+
+// decoder reads the few JSON values of an interval from s, skipping white space.
+type decoder struct {
+	s string
+}
+
+func (d *decoder) skip() {
+	d.s = strings.TrimLeft(d.s, " \t\r\n")
+}
+
+func (d *decoder) end() bool {
+	d.skip()
+	return d.s == ""
+}
+
+func (d *decoder) byte(c byte) bool {
+	d.skip()
+	if d.s == "" || d.s[0] != c {
+		return false
+	}
+	d.s = d.s[1:]
+	return true
+}
+
+func (d *decoder) literal(l string) bool {
+	d.skip()
+	rest, found := strings.CutPrefix(d.s, l)
+	if found {
+		d.s = rest
+	}
+	return found
+}
+
+// string reads a string without escapes, which is all an interval has.
+func (d *decoder) string() (string, bool) {
+	if !d.byte('"') {
+		return "", false
+	}
+	end := strings.IndexAny(d.s, `"\`)
+	if end < 0 || d.s[end] != '"' {
+		return "", false
+	}
+	s := d.s[:end]
+	d.s = d.s[end+1:]
+	return s, true
+}
+
+// boolean reads true or false.
+func (d *decoder) boolean() (v, ok bool) {
+	if d.literal("true") {
+		return true, true
+	}
+	return false, d.literal("false")
+}
+
+// number reads a number, or null which is returned as unbounded.
+func (d *decoder) number() (v float64, unbounded, ok bool) {
+	if d.literal("null") {
+		return 0, true, true
+	}
+	end := strings.IndexAny(d.s, ",} \t\r\n")
+	if end < 0 {
+		end = len(d.s)
+	}
+	v, er := strconv.ParseFloat(d.s[:end], 64)
+	if er != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, false, false
+	}
+	d.s = d.s[end:]
+	return v, false, true
+}
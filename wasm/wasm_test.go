@@ -0,0 +1,111 @@
+package wasm
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/bertverhees/interval"
+)
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		lower, upper float64
+		bounds       string
+		json         string
+	}{
+		{0, 5, "[)", `{"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}`},
+		{0.5, 5, "(]", `{"v":2,"lower":0.5,"upper":5,"lowerIncluded":false,"upperIncluded":true}`},
+		{math.Inf(-1), 5, "[]", `{"v":2,"lower":null,"upper":5,"lowerIncluded":false,"upperIncluded":true}`},
+		{0, math.Inf(1), "()", `{"v":2,"lower":0,"upper":null,"lowerIncluded":false,"upperIncluded":false}`},
+		{5, 5, "[)", "null"},
+	} {
+		if s, er := New(tc.lower, tc.upper, tc.bounds); er != nil || s != tc.json {
+			t.Errorf("want New(%v, %v, %s) = %s but is %s, %v", tc.lower, tc.upper, tc.bounds, tc.json, s, er)
+		}
+	}
+	if _, er := New(0, 5, "[["); !errors.Is(er, ErrBounds) {
+		t.Errorf("want New(0, 5, [[) to fail with ErrBounds but get %v", er)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, s := range []string{`{"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}`, `{"v":2,"lower":null,"upper":-2.5,"lowerIncluded":false,"upperIncluded":true}`, "null"} {
+		i, er := Unmarshal(s)
+		if er != nil || Marshal(i) != s {
+			t.Errorf("want Marshal(Unmarshal(%s)) = %s but is %s, %v", s, s, Marshal(i), er)
+		}
+	}
+	if i, er := Unmarshal(`{"lower":0,"upper":5,"lowerIncluded":true}`); er != nil || i.String() != "[0,5)" {
+		t.Errorf("want Unmarshal of unversioned JSON to be [0,5) but get %v, %v", i, er)
+	}
+	if _, er := Unmarshal(`{"v":3,"lower":0,"upper":5}`); !errors.Is(er, interval.ErrSchemaVersion) {
+		t.Errorf("want Unmarshal of a later version to fail with ErrSchemaVersion but get %v", er)
+	}
+	if i, er := Unmarshal(` { "upperIncluded" : true , "upper" : 1e3, "lower": -1, "lowerIncluded":true } `); er != nil || i.String() != "[-1,1000]" {
+		t.Errorf("want Unmarshal to read fields in any order with spaces but get %v, %v", i, er)
+	}
+	for _, s := range []string{"", "{", `{"v":2,"lower":"a"}`, `{"v":2,"lower":0,"width":5}`, `{"v":2,"lower":0}}`, `{"lowerIncluded":1}`, `{"lower":null,"lowerIncluded":true}`, `{"lower":5,"upper":0}`, "nul"} {
+		if _, er := Unmarshal(s); er == nil {
+			t.Errorf("want Unmarshal(%s) to fail", s)
+		}
+	}
+}
+
+func TestRootJSON(t *testing.T) {
+	for _, x := range []*interval.Interval[float64]{
+		interval.ClosedOpen(0.0, 5), interval.OpenClosed(-2.5, 1e21), interval.AtMost(1e-7), interval.Greater(1000000.0),
+		interval.All[float64](), interval.Point(0.1),
+	} {
+		root, er := json.Marshal(x)
+		if er != nil {
+			t.Fatal(er)
+		}
+		if s := Marshal(x); s != string(root) {
+			t.Errorf("want Marshal(%s) = %s as the interval package writes it but is %s", x, root, s)
+		}
+		if i, er := Unmarshal(string(root)); er != nil || !i.Equal(x) {
+			t.Errorf("want Unmarshal(%s) = %s but is %v, %v", root, x, i, er)
+		}
+		var y interval.Interval[float64]
+		if er := json.Unmarshal([]byte(Marshal(x)), &y); er != nil || !y.Equal(x) {
+			t.Errorf("want the interval package to read %s as %s but get %s, %v", Marshal(x), x, &y, er)
+		}
+	}
+	if s, er := New(0, 5, "[)"); er != nil || s != `{"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}` {
+		t.Errorf("want New(0, 5, [)) in the JSON of the interval package but is %s, %v", s, er)
+	}
+}
+
+func TestOperations(t *testing.T) {
+	a := `{"v":2,"lower":0,"upper":10,"lowerIncluded":true,"upperIncluded":false}`
+	b := `{"v":2,"lower":5,"upper":null,"lowerIncluded":true,"upperIncluded":false}`
+	if s, er := Intersect(a, b); er != nil || s != `{"v":2,"lower":5,"upper":10,"lowerIncluded":true,"upperIncluded":false}` {
+		t.Errorf("want Intersect = [5,10) but is %s, %v", s, er)
+	}
+	if s, er := Join(a, b); er != nil || s != `{"v":2,"lower":0,"upper":null,"lowerIncluded":true,"upperIncluded":false}` {
+		t.Errorf("want Join = [0,...) but is %s, %v", s, er)
+	}
+	if s, er := Subtract(a, b); er != nil || s != `[{"v":2,"lower":0,"upper":5,"lowerIncluded":true,"upperIncluded":false}]` {
+		t.Errorf("want Subtract = [[0,5)] but is %s, %v", s, er)
+	}
+	if s, er := Subtract(a, "null"); er != nil || s != "["+a+"]" {
+		t.Errorf("want Subtract of null = [%s] but is %s, %v", a, s, er)
+	}
+	if ok, er := Has(a, 10); er != nil || ok {
+		t.Errorf("want Has(10) to be false for [0,10) but is %v, %v", ok, er)
+	}
+	if ok, er := Contains(b, `{"v":2,"lower":5,"upper":6,"lowerIncluded":true,"upperIncluded":true}`); er != nil || !ok {
+		t.Errorf("want Contains to be true but is %v, %v", ok, er)
+	}
+	if ok, er := Overlaps(a, "null"); er != nil || ok {
+		t.Errorf("want Overlaps with null to be false but is %v, %v", ok, er)
+	}
+	if s, er := Format(a); er != nil || s != "[0,10)" {
+		t.Errorf("want Format = [0,10) but is %s, %v", s, er)
+	}
+	if _, er := Intersect(a, "{"); !errors.Is(er, ErrJSON) {
+		t.Errorf("want Intersect of invalid JSON to fail with ErrJSON but get %v", er)
+	}
+}